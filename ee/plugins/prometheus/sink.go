@@ -0,0 +1,126 @@
+// Copyright 2025 samber.
+//
+// Licensed as an Enterprise License (the "License"); you may not use
+// this file except in compliance with the License. You may obtain
+// a copy of the License at:
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.ee.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package roprometheus
+
+// The operators below bridge pipelines into user-owned Prometheus metrics.
+// They are not license gated, because they do not touch the introspection
+// machinery: they only drive metrics that the caller registered themselves.
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/ro"
+)
+
+// ToGauge sets the gauge to the value of every item emitted by the source
+// Observable, then forwards the item downstream.
+func ToGauge(gauge prometheus.Gauge) func(ro.Observable[float64]) ro.Observable[float64] {
+	return func(source ro.Observable[float64]) ro.Observable[float64] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[float64]) ro.Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value float64) {
+						gauge.Set(value)
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// ToGaugeWithLastUpdate is similar to ToGauge, but also sets the companion
+// lastUpdate gauge to the current unix timestamp on every item and on terminal
+// events, so staleness of the main gauge is visible.
+func ToGaugeWithLastUpdate(gauge prometheus.Gauge, lastUpdate prometheus.Gauge) func(ro.Observable[float64]) ro.Observable[float64] {
+	return func(source ro.Observable[float64]) ro.Observable[float64] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[float64]) ro.Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value float64) {
+						gauge.Set(value)
+						lastUpdate.SetToCurrentTime()
+						destination.NextWithContext(ctx, value)
+					},
+					func(ctx context.Context, err error) {
+						lastUpdate.SetToCurrentTime()
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						lastUpdate.SetToCurrentTime()
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// ToGaugeVec sets one gauge of the vector per item emitted by the source
+// Observable, using the project callback to extract the labels and the value,
+// then forwards the item downstream.
+func ToGaugeVec[T any](vec *prometheus.GaugeVec, project func(item T) (prometheus.Labels, float64)) func(ro.Observable[T]) ro.Observable[T] {
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						labels, v := project(value)
+						vec.With(labels).Set(v)
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// ToCounterAdd adds the value of every item emitted by the source Observable
+// to the counter, then forwards the item downstream. Values must be
+// non-negative, since Prometheus counters cannot decrease.
+func ToCounterAdd(counter prometheus.Counter) func(ro.Observable[float64]) ro.Observable[float64] {
+	return func(source ro.Observable[float64]) ro.Observable[float64] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[float64]) ro.Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value float64) {
+						counter.Add(value)
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
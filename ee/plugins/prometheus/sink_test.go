@@ -0,0 +1,142 @@
+// Copyright 2025 samber.
+//
+// Licensed as an Enterprise License (the "License"); you may not use
+// this file except in compliance with the License. You may obtain
+// a copy of the License at:
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.ee.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package roprometheus
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToGauge(t *testing.T) {
+	// t.Parallel()
+	is := assert.New(t)
+
+	var myGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "my_gauge",
+			Help: "My gauge",
+		},
+	)
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(myGauge)
+
+	obs := ro.Pipe1(
+		ro.Just(1.0, 2.0, 3.5),
+		ToGauge(myGauge),
+	)
+
+	values, err := ro.Collect(obs)
+	is.Equal([]float64{1.0, 2.0, 3.5}, values)
+	is.NoError(err)
+
+	is.Equal(3.5, testutil.ToFloat64(myGauge))
+}
+
+func TestToGaugeWithLastUpdate(t *testing.T) {
+	// t.Parallel()
+	is := assert.New(t)
+
+	var myGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "my_gauge",
+			Help: "My gauge",
+		},
+	)
+	var lastUpdate = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "my_gauge_last_update_timestamp",
+			Help: "My gauge last update",
+		},
+	)
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(myGauge, lastUpdate)
+
+	obs := ro.Pipe1(
+		ro.Just(42.0),
+		ToGaugeWithLastUpdate(myGauge, lastUpdate),
+	)
+
+	_, err := ro.Collect(obs)
+	is.NoError(err)
+
+	is.Equal(42.0, testutil.ToFloat64(myGauge))
+	is.Greater(testutil.ToFloat64(lastUpdate), 0.0)
+}
+
+func TestToGaugeVec(t *testing.T) {
+	// t.Parallel()
+	is := assert.New(t)
+
+	type measure struct {
+		host  string
+		value float64
+	}
+
+	var myGaugeVec = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "my_gauge_vec",
+			Help: "My gauge vec",
+		},
+		[]string{"host"},
+	)
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(myGaugeVec)
+
+	obs := ro.Pipe1(
+		ro.Just(
+			measure{host: "a", value: 1.0},
+			measure{host: "b", value: 2.0},
+			measure{host: "a", value: 3.0},
+		),
+		ToGaugeVec(myGaugeVec, func(item measure) (prometheus.Labels, float64) {
+			return prometheus.Labels{"host": item.host}, item.value
+		}),
+	)
+
+	_, err := ro.Collect(obs)
+	is.NoError(err)
+
+	is.Equal(3.0, testutil.ToFloat64(myGaugeVec.With(prometheus.Labels{"host": "a"})))
+	is.Equal(2.0, testutil.ToFloat64(myGaugeVec.With(prometheus.Labels{"host": "b"})))
+}
+
+func TestToCounterAdd(t *testing.T) {
+	// t.Parallel()
+	is := assert.New(t)
+
+	var myCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "my_counter",
+			Help: "My counter",
+		},
+	)
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(myCounter)
+
+	obs := ro.Pipe1(
+		ro.Just(1.0, 2.0, 3.0),
+		ToCounterAdd(myCounter),
+	)
+
+	_, err := ro.Collect(obs)
+	is.NoError(err)
+
+	is.Equal(6.0, testutil.ToFloat64(myCounter))
+}
@@ -49,31 +49,80 @@ var (
 	//nolint:revive
 	ErrRangeWithStepWrongStep                       = errors.New("ro.RangeWithStep: step must be greater than 0")
 	ErrRangeWithStepAndIntervalWrongStep            = errors.New("ro.RangeWithStepAndInterval: step must be greater than 0")
+	ErrRangeWithYieldWrongYieldEvery                = errors.New("ro.RangeWithYield: yieldEvery must be greater than 0")
 	ErrFirstEmpty                                   = errors.New("ro.First: empty")
 	ErrLastEmpty                                    = errors.New("ro.Last: empty")
 	ErrHeadEmpty                                    = errors.New("ro.First: empty")
 	ErrTailEmpty                                    = errors.New("ro.Last: empty")
+	ErrEmpty                                        = errors.New("ro.Single: empty")
+	ErrTooManyElements                              = errors.New("ro.Single: too many elements")
 	ErrTakeWrongCount                               = errors.New("ro.Take: count must be greater or equal to 0")
 	ErrTakeLastWrongCount                           = errors.New("ro.TakeLast: count must be greater than 0")
 	ErrSkipWrongCount                               = errors.New("ro.Skip: count must be greater or equal to 0")
 	ErrSkipLastWrongCount                           = errors.New("ro.SkipLast: count must be greater than 0")
+	ErrSlidingWrongSize                             = errors.New("ro.Sliding: size must be greater or equal to 2")
+	ErrDistinctByWithTTLWrongTTL                    = errors.New("ro.DistinctByWithTTL: ttl must be greater than 0")
+	ErrDistinctByWithTTLWrongMaxKeys                = errors.New("ro.DistinctByWithTTL: maxKeys must be greater than 0")
 	ErrElementAtWrongNth                            = errors.New("ro.ElementAt: nth must be greater or equal to 0")
 	ErrElementAtNotFound                            = errors.New("ro.ElementAt: nth element not found")
 	ErrElementAtOrDefaultWrongNth                   = errors.New("ro.ElementAtOrDefault: nth must be greater or equal to 0")
+	ErrRetryWithConfigWrongBackoffFactor            = errors.New("ro.RetryWithConfig: backoff factor must be greater or equal to 1")
+	ErrRetryWithConfigWrongJitter                   = errors.New("ro.RetryWithConfig: jitter must be between 0 and 1")
+	ErrRetryUntilWrongDeadline                      = errors.New("ro.RetryUntil: deadline must be greater than 0")
 	ErrRepeatWrongCount                             = errors.New("ro.Repeat: count must be greater or equal to 0")
 	ErrRepeatWithIntervalWrongCount                 = errors.New("ro.RepeatWithInterval: count must be greater or equal to 0")
 	ErrRepeatWithWrongCount                         = errors.New("ro.RepeatWith: count must be greater or equal to 0")
 	ErrBufferWithCountWrongSize                     = errors.New("ro.BufferWithCount: size must be greater than 0")
+	ErrBufferWithCountAndSkipWrongSize              = errors.New("ro.BufferWithCountAndSkip: size must be greater than 0")
+	ErrBufferWithCountAndSkipWrongStartEvery        = errors.New("ro.BufferWithCountAndSkip: startEvery must be greater than 0")
 	ErrBufferWithTimeWrongDuration                  = errors.New("ro.BufferWithTime: duration must be greater than 0")
 	ErrBufferWithTimeOrCountWrongSize               = errors.New("ro.BufferWithTimeOrCount: size must be greater than 0")
 	ErrBufferWithTimeOrCountWrongDuration           = errors.New("ro.BufferWithTimeOrCount: duration must be greater than 0")
+	ErrMapConcurrentWrongConcurrency                = errors.New("ro.MapConcurrent: concurrency must be greater than 0")
+	ErrMapConcurrentUnorderedWrongConcurrency       = errors.New("ro.MapConcurrentUnordered: concurrency must be greater than 0")
+	ErrBatchByKeyWrongSize                          = errors.New("ro.BatchByKey: size must be greater than 0")
+	ErrBatchByKeyWrongInterval                      = errors.New("ro.BatchByKey: interval must be greater than 0")
+	ErrDedupSequenceWrongWindowSize                 = errors.New("ro.DedupSequence: windowSize must be greater than 0")
+	ErrEnsureMonotonicWrongPolicy                   = errors.New("ro.EnsureMonotonic: unexpected monotonic policy")
+	ErrEnsureMonotonicOutOfOrder                    = errors.New("ro.EnsureMonotonic: out-of-order value")
+	ErrGroupByWithConfigWrongMaxGroups              = errors.New("ro.GroupByWithConfig: maxGroups must be greater or equal to 0")
+	ErrGroupByWithConfigWrongIdleTimeout            = errors.New("ro.GroupByWithConfig: idleTimeout must be greater or equal to 0")
+	ErrHoppingWindowWrongWindowSize                 = errors.New("ro.HoppingWindow: windowSize must be greater than 0")
+	ErrHoppingWindowWrongHop                        = errors.New("ro.HoppingWindow: hop must be greater than 0")
+	ErrTumblingBillingWindowWrongWindow             = errors.New("ro.TumblingBillingWindow: window must be greater than 0")
+	ErrTumblingBillingWindowMissingBoundary         = errors.New("ro.TumblingBillingWindow: missing boundary function")
+	ErrWindowWithCountWrongSize                     = errors.New("ro.WindowWithCount: size must be greater than 0")
+	ErrWindowWithTimeWrongDuration                  = errors.New("ro.WindowWithTime: duration must be greater than 0")
+	ErrIdleTimeout                                  = errors.New("ro.IdleTimeout: no emission within duration")
+	ErrRateLimitWrongRate                           = errors.New("ro.RateLimit: rate must be greater than 0")
+	ErrRateLimitWrongBurst                          = errors.New("ro.RateLimit: burst must be greater than 0")
+	ErrSampleOrLatestWrongPeriod                    = errors.New("ro.SampleOrLatest: period must be greater than 0")
+	ErrResampleWrongPeriod                          = errors.New("ro.Resample: period must be greater than 0")
+	ErrResampleWrongInterpolationMode               = errors.New("ro.Resample: unexpected interpolation mode")
 	ErrClampLowerLessThanUpper                      = errors.New("ro.Clamp: lower must be less than or equal to upper")
+	ErrPercentileWrongPercentile                    = errors.New("ro.Percentile: p must be between 0 and 100")
+	ErrPercentileWrongWindow                        = errors.New("ro.Percentile: window must be greater than 0")
+	ErrPercentileAllWrongPercentile                 = errors.New("ro.PercentileAll: p must be greater than 0 and less than or equal to 100")
 	ErrToChannelWrongSize                           = errors.New("ro.ErrToChannelWrongSize: size must be greater or equal to 0")
+	ErrToChannelBatchedWrongMaxBatch                = errors.New("ro.ToChannelBatched: maxBatch must be greater than 0")
+	ErrToChannelBatchedWrongMaxDelay                = errors.New("ro.ToChannelBatched: maxDelay must be greater than 0")
+	ErrToSliceWithCapacityWrongCapacity             = errors.New("ro.ToSliceWithCapacity: capacity must be greater or equal to 0")
+	ErrToSliceWithCapWrongMaxItems                  = errors.New("ro.ToSliceWithCap: maxItems must be greater or equal to 0")
+	ErrToSliceExceededMaxItems                      = errors.New("ro.ToSlice: exceeded max items")
+	ErrToMapDuplicateKey                            = errors.New("ro.ToMap: duplicate key")
+	ErrMergePriorityWrongCapacity                   = errors.New("ro.MergePriority: capacity must be greater than 0")
+	ErrMergePriorityOverflow                        = errors.New("ro.MergePriority: buffer overflow")
+	ErrOnBackpressureBufferWrongCapacity            = errors.New("ro.OnBackpressureBuffer: capacity must be greater than 0")
+	ErrBufferOverflow                               = errors.New("ro.OnBackpressureBuffer: buffer overflow")
 	ErrPoolWrongSize                                = errors.New("ro.Pool: size must be greater than 0")
+	ErrWorkerPoolSchedulerWrongSize                 = errors.New("ro.NewWorkerPoolScheduler: size must be greater than 0")
 	ErrSubscribeOnWrongBufferSize                   = errors.New("ro.SubscribeOn: buffer size must be greater than 0")
 	ErrObserveOnWrongBufferSize                     = errors.New("ro.ObserveOn: buffer size must be greater than 0")
 	ErrDetachOnWrongMode                            = errors.New("ro.detachOn: unexpected detach mode")
 	ErrUnicastSubjectConcurrent                     = errors.New("ro.UnicastSubject: a single subscriber accepted")
+	ErrPublishSubjectWithConfigWrongBufferSize      = errors.New("ro.NewPublishSubjectWithConfig: buffer size must be greater than 0")
+	ErrPublishSubjectWithConfigWrongStrategy        = errors.New("ro.NewPublishSubjectWithConfig: unexpected overflow strategy")
+	ErrSubjectBufferOverflow                        = errors.New("ro.NewPublishSubjectWithConfig: buffer overflow")
 	ErrConnectableObservableMissingConnectorFactory = errors.New("ro.ConnectableObservable: missing connector factory")
 )
 
@@ -136,6 +185,10 @@ func (e *observerError) Unwrap() error {
 	return e.err
 }
 
+// ErrTimeout is wrapped by the error emitted by Timeout, TimeoutFirst and
+// TimeoutEach, so that it can be matched with errors.Is.
+var ErrTimeout = errors.New("ro.Timeout: timeout")
+
 func newTimeoutError(duration time.Duration) error {
 	return &timeoutError{
 		duration: duration,
@@ -150,6 +203,10 @@ func (e *timeoutError) Error() string {
 	return "ro.Timeout: timeout after " + e.duration.String()
 }
 
+func (e *timeoutError) Unwrap() error {
+	return ErrTimeout
+}
+
 func newCastError[T, U any]() error {
 	return &castError[T, U]{}
 }
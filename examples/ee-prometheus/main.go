@@ -20,6 +20,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"syscall"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -118,6 +119,14 @@ func main() {
 	// Optional, since the pipeline will complete itself, in a blocking way.
 	defer subscription.Unsubscribe()
 
-	// Keep the main goroutine alive to serve metrics
-	select {}
+	// Keep the main goroutine alive to serve metrics, then exit cleanly on
+	// SIGINT/SIGTERM.
+	ro.Pipe1(
+		ro.FromSignal(syscall.SIGINT, syscall.SIGTERM),
+		ro.Take[os.Signal](1),
+	).Subscribe(
+		ro.OnNext(func(sig os.Signal) {
+			log.Printf("Received %s, shutting down", sig)
+		}),
+	).Wait()
 }
@@ -12,7 +12,6 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-
 package main
 
 import (
@@ -47,8 +46,10 @@ func getUserPreferences() (map[string]any, error) {
 }
 
 // Wait for all requests to complete and combine the results.
+// ForkJoin3 emits a single tuple of final values once every source has
+// completed, without the intermediate combinations CombineLatest3 would fire.
 var pipeline = ro.Pipe1(
-	ro.CombineLatest3(
+	ro.ForkJoin3(
 		// Future is a helper function to run a function in a goroutine.
 		// It returns an Observable that emits a single result: a value or an error.
 		ro.Future(getOrders),
@@ -320,6 +320,110 @@ func (s *observableImpl[T]) SubscribeWithContext(ctx context.Context, destinatio
 	return subscription
 }
 
+// SubscribeOption configures a call to SubscribeWithOptions.
+type SubscribeOption func(*subscribeOptions)
+
+type subscribeOptions struct {
+	ctx               context.Context
+	subscribeOnBuffer int
+	observeOnBuffer   int
+	errorHandler      func(err error)
+}
+
+// WithContext sets the context of the subscription, as if the observable had
+// been subscribed with SubscribeWithContext.
+func WithContext(ctx context.Context) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.ctx = ctx
+	}
+}
+
+// WithSubscribeOn runs the upstream flow on a dedicated goroutine with the
+// given buffer size, like the SubscribeOn operator. It panics if bufferSize
+// is lower than 1.
+func WithSubscribeOn(bufferSize int) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.subscribeOnBuffer = bufferSize
+	}
+}
+
+// WithObserveOn delivers notifications to the observer on a dedicated
+// goroutine with the given buffer size, like the ObserveOn operator. It panics
+// if bufferSize is lower than 1.
+func WithObserveOn(bufferSize int) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.observeOnBuffer = bufferSize
+	}
+}
+
+// WithErrorHandler invokes handler for every error notification, before the
+// observer's own error callback.
+func WithErrorHandler(handler func(err error)) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.errorHandler = handler
+	}
+}
+
+// SubscribeWithOptions subscribes an Observer to the Observable with
+// per-subscription configuration, without baking it into the pipeline. The
+// zero configuration is equivalent to calling Subscribe directly.
+func SubscribeWithOptions[T any](obs Observable[T], destination Observer[T], opts ...SubscribeOption) Subscription {
+	options := subscribeOptions{
+		ctx: context.Background(),
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.errorHandler != nil {
+		handler := options.errorHandler
+		observer := destination
+
+		destination = NewObserverWithContext(
+			observer.NextWithContext,
+			func(ctx context.Context, err error) {
+				handler(err)
+				observer.ErrorWithContext(ctx, err)
+			},
+			observer.CompleteWithContext,
+		)
+	}
+
+	if options.observeOnBuffer > 0 {
+		obs = ObserveOn[T](options.observeOnBuffer)(obs)
+	}
+
+	if options.subscribeOnBuffer > 0 {
+		obs = SubscribeOn[T](options.subscribeOnBuffer)(obs)
+	}
+
+	return obs.SubscribeWithContext(options.ctx, destination)
+}
+
+// SubscribeWithContext subscribes the destination Observer to the Observable
+// and unsubscribes automatically when ctx is cancelled. It is the
+// subscription-level companion of TakeUntilContext.
+func SubscribeWithContext[T any](obs Observable[T], ctx context.Context, destination Observer[T]) Subscription {
+	sub := obs.SubscribeWithContext(ctx, destination)
+
+	stop := make(chan struct{})
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			sub.Unsubscribe()
+		case <-stop:
+		}
+	}()
+
+	sub.Add(func() {
+		close(stop)
+	})
+
+	return sub
+}
+
 // Collect collects all values emitted by the source Observable and returns them
 // as a slice. It waits for the source Observable to complete before returning.
 // If the source Observable emits an error, the error is returned along with the
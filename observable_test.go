@@ -864,3 +864,95 @@ func TestConnectableWithConfig(t *testing.T) {
 	is.Equal([]int{1, 2, 3}, b)
 	is.Equal([]string{"1", "2", "3"}, c)
 }
+
+func TestSubscribeWithOptions(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// zero configuration behaves like Subscribe
+	values := []int{}
+	sub := SubscribeWithOptions(
+		Just(1, 2, 3),
+		OnNext(func(value int) { values = append(values, value) }),
+	)
+	sub.Wait()
+	is.Equal([]int{1, 2, 3}, values)
+
+	// WithContext threads the context down to the observer
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "yes")
+
+	witnessed := ""
+	sub = SubscribeWithOptions(
+		Just(1),
+		NewObserverWithContext(
+			func(ctx context.Context, value int) {
+				witnessed, _ = ctx.Value(ctxKey{}).(string)
+			},
+			nil,
+			nil,
+		),
+		WithContext(ctx),
+	)
+	sub.Wait()
+	is.Equal("yes", witnessed)
+
+	// WithErrorHandler runs before the observer's own error callback
+	events := []string{}
+	sub = SubscribeWithOptions(
+		Throw[int](assert.AnError),
+		NewObserver(
+			func(value int) {},
+			func(err error) { events = append(events, "observer") },
+			func() {},
+		),
+		WithErrorHandler(func(err error) {
+			is.EqualError(err, assert.AnError.Error())
+			events = append(events, "handler")
+		}),
+	)
+	sub.Wait()
+	is.Equal([]string{"handler", "observer"}, events)
+
+	// WithSubscribeOn and WithObserveOn detach the flow without losing values
+	values = []int{}
+	sub = SubscribeWithOptions(
+		Just(1, 2, 3),
+		OnNext(func(value int) { values = append(values, value) }),
+		WithSubscribeOn(16),
+		WithObserveOn(16),
+	)
+	sub.Wait()
+	is.Equal([]int{1, 2, 3}, values)
+}
+func TestSubscribeWithContextHelper(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	// cancellation unsubscribes automatically
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mu := lo.Synchronize()
+	values := []int64{}
+	sub := SubscribeWithContext(
+		RangeWithInterval(0, 100, 20*time.Millisecond),
+		ctx,
+		OnNext(func(value int64) {
+			mu.Do(func() { values = append(values, value) })
+		}),
+	)
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	sub.Wait()
+
+	is.True(sub.IsClosed())
+	mu.Do(func() { is.Less(len(values), 5) })
+
+	// normal completion cleans up the watcher goroutine
+	sub = SubscribeWithContext(Just(1, 2, 3), context.Background(), OnNext(func(value int) {}))
+	sub.Wait()
+	is.True(sub.IsClosed())
+}
@@ -90,6 +90,16 @@ func NewObserverWithContext[T any](onNext func(ctx context.Context, value T), on
 	}
 }
 
+// NewSerializedObserver wraps the destination Observer so that Next, Error and
+// Complete are delivered one at a time, even when called concurrently from
+// multiple goroutines. Once a terminal notification has been delivered, late
+// values are dropped. It is the observer-side counterpart of the Serialize
+// operator, and is mostly useful when feeding a Subject from multiple
+// goroutines.
+func NewSerializedObserver[T any](destination Observer[T]) Observer[T] {
+	return NewSafeSubscriber(destination)
+}
+
 type observerImpl[T any] struct {
 	// 0: active
 	// 1: errored
@@ -835,3 +835,56 @@ func TestObserverMemoryLeak(t *testing.T) {
 		is.True(observers[i].IsClosed())
 	}
 }
+
+func TestNewSerializedObserver(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 5*time.Second)
+	is := assert.New(t)
+
+	// The inner observer is deliberately unsynchronized: the race detector
+	// would flag any overlapping deliveries.
+	sum := 0
+	completed := 0
+
+	observer := NewSerializedObserver(NewObserver(
+		func(value int) {
+			sum += value
+		},
+		func(err error) {},
+		func() {
+			completed++
+		},
+	))
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 500; j++ {
+				observer.Next(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	is.Equal(4000, sum)
+	is.False(observer.IsClosed())
+
+	observer.Complete()
+	is.True(observer.IsClosed())
+	is.Equal(1, completed)
+
+	// late notifications are dropped
+	observer.Next(42)
+	observer.Complete()
+	is.Equal(4000, sum)
+	is.Equal(1, completed)
+
+	// a serialized observer is not re-wrapped
+	is.Equal(observer, NewSerializedObserver(observer))
+}
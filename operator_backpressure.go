@@ -0,0 +1,207 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/samber/ro/internal/xsync"
+)
+
+// OverflowStrategy is the policy applied by OnBackpressureBuffer when the
+// internal queue is full.
+type OverflowStrategy int8
+
+const (
+	// OverflowStrategyError terminates the stream with ErrBufferOverflow when
+	// the buffer is full. Buffered values are discarded.
+	OverflowStrategyError OverflowStrategy = iota
+	// OverflowStrategyDropOldest drops the oldest buffered value to make room
+	// for the incoming one.
+	OverflowStrategyDropOldest
+	// OverflowStrategyDropLatest drops the incoming value and keeps the
+	// buffered ones.
+	OverflowStrategyDropLatest
+)
+
+// OnBackpressureBuffer decouples a fast producer from a slow consumer with a
+// dedicated goroutine and an internal queue of the given capacity. When the
+// queue is full, the overflow strategy decides whether the stream errors with
+// ErrBufferOverflow or which value is dropped.
+// It panics if capacity is lower than 1.
+func OnBackpressureBuffer[T any](capacity int, strategy OverflowStrategy) func(Observable[T]) Observable[T] {
+	return OnBackpressureBufferWithCallback[T](capacity, strategy, nil)
+}
+
+// OnBackpressureBufferWithCallback behaves like OnBackpressureBuffer, and
+// additionally invokes onDrop with the running count of dropped values every
+// time a value is dropped, so it can feed a metrics counter.
+func OnBackpressureBufferWithCallback[T any](capacity int, strategy OverflowStrategy, onDrop func(dropped uint64)) func(Observable[T]) Observable[T] {
+	if capacity < 1 {
+		panic(ErrOnBackpressureBufferWrongCapacity)
+	}
+
+	return onBackpressure[T](capacity, strategy, onDrop)
+}
+
+// OnBackpressureDrop decouples a fast producer from a slow consumer and drops
+// incoming values while the consumer is busy, keeping the value currently
+// waiting for delivery.
+func OnBackpressureDrop[T any]() func(Observable[T]) Observable[T] {
+	return OnBackpressureDropWithCallback[T](nil)
+}
+
+// OnBackpressureDropWithCallback behaves like OnBackpressureDrop, and
+// additionally invokes onDrop with the running count of dropped values every
+// time a value is dropped, so it can feed a metrics counter.
+func OnBackpressureDropWithCallback[T any](onDrop func(dropped uint64)) func(Observable[T]) Observable[T] {
+	return onBackpressure[T](1, OverflowStrategyDropLatest, onDrop)
+}
+
+// OnBackpressureLatest decouples a fast producer from a slow consumer and
+// keeps only the latest value while the consumer is busy, dropping the older
+// pending one.
+func OnBackpressureLatest[T any]() func(Observable[T]) Observable[T] {
+	return OnBackpressureLatestWithCallback[T](nil)
+}
+
+// OnBackpressureLatestWithCallback behaves like OnBackpressureLatest, and
+// additionally invokes onDrop with the running count of dropped values every
+// time a value is dropped, so it can feed a metrics counter.
+func OnBackpressureLatestWithCallback[T any](onDrop func(dropped uint64)) func(Observable[T]) Observable[T] {
+	return onBackpressure[T](1, OverflowStrategyDropOldest, onDrop)
+}
+
+func onBackpressure[T any](capacity int, strategy OverflowStrategy, onDrop func(dropped uint64)) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+			queue := []lo.Tuple2[context.Context, Notification[T]]{}
+			dropped := uint64(0)
+			stopped := false
+
+			signal := make(chan struct{}, 1)
+			stop := make(chan struct{})
+
+			notify := func() {
+				select {
+				case signal <- struct{}{}:
+				default:
+				}
+			}
+
+			// must be called with mu held
+			drop := func() {
+				dropped++
+
+				if onDrop != nil {
+					onDrop(dropped)
+				}
+			}
+
+			push := func(ctx context.Context, notif Notification[T]) {
+				mu.Lock()
+
+				if stopped {
+					mu.Unlock()
+					return
+				}
+
+				if notif.Kind == KindNext && len(queue) >= capacity {
+					switch strategy {
+					case OverflowStrategyDropOldest:
+						queue = queue[1:]
+						drop()
+					case OverflowStrategyDropLatest:
+						drop()
+						mu.Unlock()
+						return
+					default:
+						// fail fast: pending values are discarded
+						queue = []lo.Tuple2[context.Context, Notification[T]]{
+							lo.T2(ctx, NewNotificationError[T](ErrBufferOverflow)),
+						}
+						stopped = true
+						mu.Unlock()
+						notify()
+						return
+					}
+				}
+
+				queue = append(queue, lo.T2(ctx, notif))
+
+				mu.Unlock()
+				notify()
+			}
+
+			go func() {
+				for {
+					select {
+					case <-stop:
+						return
+					case <-signal:
+					}
+
+					for {
+						mu.Lock()
+
+						if len(queue) == 0 {
+							mu.Unlock()
+							break
+						}
+
+						head := queue[0]
+						queue = queue[1:]
+
+						mu.Unlock()
+
+						if !processNotificationWithObserverAndContext(head.A, head.B, destination) {
+							return
+						}
+					}
+				}
+			}()
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						push(ctx, NewNotificationNext(value))
+					},
+					func(ctx context.Context, err error) {
+						push(ctx, NewNotificationError[T](err))
+					},
+					func(ctx context.Context) {
+						push(ctx, NewNotificationComplete[T]())
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				mu.Lock()
+
+				stopped = true
+				queue = []lo.Tuple2[context.Context, Notification[T]]{}
+
+				mu.Unlock()
+
+				close(stop)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// slowProducer emits 1, waits for the consumer to pick it up, then bursts the
+// remaining values and completes.
+func slowProducer(burst ...int) Observable[int] {
+	return NewObservable(func(destination Observer[int]) Teardown {
+		destination.Next(1)
+		time.Sleep(20 * time.Millisecond)
+
+		for _, value := range burst {
+			destination.Next(value)
+		}
+
+		destination.Complete()
+
+		return nil
+	})
+}
+
+// slowConsumer blocks the downstream goroutine long enough for the whole
+// burst to hit the queue.
+func slowConsumer() func(Observable[int]) Observable[int] {
+	return TapOnNext(func(value int) {
+		time.Sleep(100 * time.Millisecond)
+	})
+}
+
+func TestOperatorBackpressureOnBackpressureBuffer(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 2000*time.Millisecond)
+	is := assert.New(t)
+
+	// a large enough buffer is a passthrough
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			OnBackpressureBuffer[int](10, OverflowStrategyError),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// overflow with OverflowStrategyError fails fast
+	values, err = Collect(
+		Pipe2(
+			slowProducer(2, 3, 4),
+			OnBackpressureBuffer[int](2, OverflowStrategyError),
+			slowConsumer(),
+		),
+	)
+	is.Equal([]int{1}, values)
+	is.EqualError(err, ErrBufferOverflow.Error())
+
+	// overflow with OverflowStrategyDropOldest keeps the most recent values
+	dropped := uint64(0)
+	values, err = Collect(
+		Pipe2(
+			slowProducer(2, 3, 4, 5),
+			OnBackpressureBufferWithCallback[int](2, OverflowStrategyDropOldest, func(count uint64) {
+				atomic.StoreUint64(&dropped, count)
+			}),
+			slowConsumer(),
+		),
+	)
+	is.Equal([]int{1, 4, 5}, values)
+	is.Equal(uint64(2), atomic.LoadUint64(&dropped))
+	is.NoError(err)
+
+	is.PanicsWithError(ErrOnBackpressureBufferWrongCapacity.Error(), func() {
+		OnBackpressureBuffer[int](0, OverflowStrategyError)
+	})
+}
+
+func TestOperatorBackpressureOnBackpressureDrop(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 2000*time.Millisecond)
+	is := assert.New(t)
+
+	dropped := uint64(0)
+	values, err := Collect(
+		Pipe2(
+			slowProducer(2, 3, 4, 5),
+			OnBackpressureDropWithCallback[int](func(count uint64) {
+				atomic.StoreUint64(&dropped, count)
+			}),
+			slowConsumer(),
+		),
+	)
+	is.Equal([]int{1, 2}, values)
+	is.Equal(uint64(3), atomic.LoadUint64(&dropped))
+	is.NoError(err)
+}
+
+func TestOperatorBackpressureOnBackpressureLatest(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 2000*time.Millisecond)
+	is := assert.New(t)
+
+	dropped := uint64(0)
+	values, err := Collect(
+		Pipe2(
+			slowProducer(2, 3, 4, 5),
+			OnBackpressureLatestWithCallback[int](func(count uint64) {
+				atomic.StoreUint64(&dropped, count)
+			}),
+			slowConsumer(),
+		),
+	)
+	is.Equal([]int{1, 5}, values)
+	is.Equal(uint64(3), atomic.LoadUint64(&dropped))
+	is.NoError(err)
+}
@@ -969,24 +969,49 @@ func EndWith[T any](suffixes ...T) func(Observable[T]) Observable[T] {
 }
 
 // Pairwise emits the previous and current values as a pair of two values.
+// It is the size=2 special case of Sliding.
 // Play: https://go.dev/play/p/0YujgFTL4e0
 func Pairwise[T any]() func(Observable[T]) Observable[[]T] {
+	return Sliding[T](2)
+}
+
+// Sliding emits windows of exactly `size` consecutive elements, sliding by one
+// element at a time. No partial window is emitted at the start or the end of
+// the stream. The history is kept in a ring buffer, so only `size` elements
+// are retained. A size lower than 2 produces an Observable erroring on
+// subscription, instead of panicking.
+func Sliding[T any](size int) func(Observable[T]) Observable[[]T] {
+	if size < 2 {
+		return func(Observable[T]) Observable[[]T] {
+			return Throw[[]T](ErrSlidingWrongSize)
+		}
+	}
+
 	return func(source Observable[T]) Observable[[]T] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
-			count := int64(0)
-
-			var last T
+			ring := make([]T, size)
+			pos := 0
+			count := 0
 
 			sub := source.SubscribeWithContext(
 				subscriberCtx,
 				NewObserverWithContext(
 					func(ctx context.Context, value T) {
-						if count > 0 {
-							destination.NextWithContext(ctx, []T{last, value})
+						ring[pos] = value
+						pos = (pos + 1) % size
+						count++
+
+						if count < size {
+							return
 						}
 
-						count++
-						last = value
+						// `pos` now points at the oldest element of the window
+						window := make([]T, size)
+						for i := 0; i < size; i++ {
+							window[i] = ring[(pos+i)%size]
+						}
+
+						destination.NextWithContext(ctx, window)
 					},
 					destination.ErrorWithContext,
 					destination.CompleteWithContext,
@@ -1600,6 +1625,146 @@ func zipAllInnerSubscriptions[T any](outerCtx context.Context, sources []Observa
 	}
 }
 
+func zipLongestInnerSubscriptions[T any](outerCtx context.Context, sources []Observable[T], fills []T, destination Observer[[]T]) Teardown {
+	var mu sync.Mutex
+
+	values := make([][]T, len(sources))
+	completed := make([]bool, len(sources))
+	done := false
+
+	onUpdate := func(ctx context.Context) {
+		mu.Lock()
+
+		for !done {
+			// a round is ready when every source has either a buffered value
+			// or nothing more to contribute
+			ready := true
+			remaining := false
+
+			for i := range sources {
+				if len(values[i]) == 0 {
+					if !completed[i] {
+						ready = false
+						break
+					}
+				} else {
+					remaining = true
+				}
+			}
+
+			if !ready {
+				break
+			}
+
+			if !remaining {
+				done = true
+				destination.CompleteWithContext(ctx)
+
+				break
+			}
+
+			result := make([]T, len(sources))
+
+			for i := range sources {
+				if len(values[i]) > 0 {
+					result[i] = values[i][0]
+					values[i] = values[i][1:]
+				} else {
+					result[i] = fills[i]
+				}
+			}
+
+			mu.Unlock() // unlock before calling destination.Next to prevent long locks
+			destination.NextWithContext(ctx, result)
+			mu.Lock()
+		}
+
+		mu.Unlock()
+	}
+
+	subscriptions := NewSubscription(nil)
+
+	for i := range sources {
+		index := i
+
+		subscriptions.AddUnsubscribable(
+			sources[i].SubscribeWithContext(
+				outerCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						mu.Lock()
+						values[index] = append(values[index], value)
+						mu.Unlock()
+
+						onUpdate(ctx)
+					},
+					func(ctx context.Context, err error) {
+						mu.Lock()
+
+						if !done {
+							done = true
+							destination.ErrorWithContext(ctx, err)
+						}
+
+						mu.Unlock()
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+						completed[index] = true
+						mu.Unlock()
+
+						onUpdate(ctx)
+					},
+				),
+			),
+		)
+	}
+
+	return subscriptions.Unsubscribe
+}
+
+// ZipAllLongest combines the values from the inner Observables like ZipAll,
+// but keeps emitting once a source has completed, substituting the fill value
+// for its missing items. It completes when every source has completed and
+// every buffer has been drained. Errors still short-circuit.
+func ZipAllLongest[T any](fill T) func(Observable[Observable[T]]) Observable[[]T] {
+	return func(sources Observable[Observable[T]]) Observable[[]T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
+			innerSub := NewSubscription(nil)
+
+			// First, we consume the high-order observable...
+			outerSub := ToSlice[Observable[T]]()(sources).
+				SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, flattenSources []Observable[T]) {
+							fills := make([]T, len(flattenSources))
+							for i := range fills {
+								fills[i] = fill
+							}
+
+							innerSub.Add(
+								// ...then we zip all inner observables.
+								zipLongestInnerSubscriptions(ctx, flattenSources, fills, destination),
+							)
+						},
+						func(ctx context.Context, err error) {
+							destination.ErrorWithContext(ctx, err)
+						},
+						func(ctx context.Context) {
+							destination.CompleteWithContext(ctx)
+						},
+					),
+				)
+
+			return func() {
+				outerSub.Unsubscribe()
+				innerSub.Unsubscribe()
+			}
+		})
+	}
+}
+
 // ZipAll combines the values from the source Observable with the latest values
 // from the other Observables. It emits only when all Observables have emitted
 // at least one value. It completes when the source Observable completes.
@@ -590,6 +590,49 @@ func TestOperatorCombiningPairwise(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorCombiningSliding(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Sliding[int](3)(Just(1, 2, 3, 4, 5)),
+	)
+	is.Equal([][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, values)
+	is.NoError(err)
+
+	// no partial window
+	values, err = Collect(
+		Sliding[int](3)(Just(1, 2)),
+	)
+	is.Equal([][]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Sliding[int](2)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{{1, 2}, {2, 3}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Sliding[int](3)(Empty[int]()),
+	)
+	is.Equal([][]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Sliding[int](3)(Throw[int](assert.AnError)),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		Sliding[int](1)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, ErrSlidingWrongSize.Error())
+}
+
 func TestOperatorCombiningRaceWith(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 1500*time.Millisecond)
@@ -17,7 +17,7 @@ package ro
 import (
 	"context"
 
-	"github.com/samber/lo"
+	"github.com/samber/ro/internal/xsync"
 )
 
 // All determines whether all elements of an observable sequence satisfy a condition.
@@ -229,30 +229,192 @@ func DefaultIfEmptyWithContext[T any](defaultCtx context.Context, defaultValue T
 	}
 }
 
-// SequenceEqual determines whether two observable sequences are equal by comparing the elements pairwise.
-// Play: https://go.dev/play/p/cBIQlH01byQ
-func SequenceEqual[T comparable](obsB Observable[T]) func(Observable[T]) Observable[bool] {
+// IsEmpty emits a single bool indicating whether the source observable emitted
+// any item. It emits false and completes as soon as the first value arrives,
+// without waiting for the source to complete; it emits true only when the
+// source completes empty. Errors are propagated.
+func IsEmpty[T any]() func(Observable[T]) Observable[bool] {
 	return func(source Observable[T]) Observable[bool] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[bool]) Teardown {
-			sub := Zip2(source, obsB).
-				SubscribeWithContext(
+			empty := true
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if empty {
+							empty = false
+
+							destination.NextWithContext(ctx, false)
+							destination.CompleteWithContext(ctx)
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if empty {
+							destination.NextWithContext(ctx, true)
+							destination.CompleteWithContext(ctx)
+						}
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// SwitchIfEmpty subscribes to a fallback observable if the source observable
+// completes without emitting any item. Unlike DefaultIfEmpty, the fallback is
+// a whole observable rather than a single value, and it is only subscribed
+// when needed. Errors from the source or the fallback are propagated.
+func SwitchIfEmpty[T any](fallback Observable[T]) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			subscriptions := NewSubscription(nil)
+			empty := true
+
+			subscriptions.AddUnsubscribable(
+				source.SubscribeWithContext(
 					subscriberCtx,
 					NewObserverWithContext(
-						func(ctx context.Context, values lo.Tuple2[T, T]) {
-							if values.A != values.B {
-								destination.NextWithContext(ctx, false)
-								destination.CompleteWithContext(ctx)
-							}
+						func(ctx context.Context, value T) {
+							empty = false
+
+							destination.NextWithContext(ctx, value)
 						},
 						destination.ErrorWithContext,
 						func(ctx context.Context) {
-							destination.NextWithContext(ctx, true)
-							destination.CompleteWithContext(ctx)
+							if empty {
+								subscriptions.AddUnsubscribable(
+									fallback.SubscribeWithContext(ctx, destination),
+								)
+							} else {
+								destination.CompleteWithContext(ctx)
+							}
 						},
 					),
+				),
+			)
+
+			return subscriptions.Unsubscribe
+		})
+	}
+}
+
+// SequenceEqual determines whether two observable sequences are equal by comparing
+// the elements pairwise. It emits a single bool: true when both sequences emit the
+// same values in the same order and complete, false on any value mismatch or when
+// the sequences have different lengths. An error from either sequence is forwarded.
+// Sources of different pacing are aligned by buffering internally, like Zip.
+// Play: https://go.dev/play/p/cBIQlH01byQ
+func SequenceEqual[T comparable](obsB Observable[T]) func(Observable[T]) Observable[bool] {
+	return SequenceEqualBy[T](obsB, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// SequenceEqualBy is like SequenceEqual, but compares elements with a custom
+// equality function instead of the == operator.
+func SequenceEqualBy[T any](obsB Observable[T], equal func(a, b T) bool) func(Observable[T]) Observable[bool] {
+	return func(source Observable[T]) Observable[bool] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[bool]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+			subscriptions := NewSubscription(nil)
+
+			queues := [2][]T{}
+			completed := [2]bool{}
+			done := false
+
+			// must be called with mu held
+			finish := func(ctx context.Context, result bool) {
+				if done {
+					return
+				}
+
+				done = true
+				destination.NextWithContext(ctx, result)
+				destination.CompleteWithContext(ctx)
+			}
+
+			makeObserver := func(index int) Observer[T] {
+				other := 1 - index
+
+				return NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						if done {
+							return
+						}
+
+						if len(queues[other]) > 0 {
+							otherValue := queues[other][0]
+							queues[other] = queues[other][1:]
+
+							a, b := value, otherValue
+							if index == 1 {
+								a, b = otherValue, value
+							}
+
+							if !equal(a, b) {
+								finish(ctx, false)
+							}
+
+							return
+						}
+
+						if completed[other] {
+							// the other sequence is exhausted: this value is extra
+							finish(ctx, false)
+
+							return
+						}
+
+						queues[index] = append(queues[index], value)
+					},
+					func(ctx context.Context, err error) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						if !done {
+							done = true
+							destination.ErrorWithContext(ctx, err)
+						}
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						if done {
+							return
+						}
+
+						completed[index] = true
+
+						if len(queues[other]) > 0 {
+							// the other sequence already emitted more items
+							finish(ctx, false)
+
+							return
+						}
+
+						if completed[other] {
+							finish(ctx, len(queues[index]) == 0)
+						}
+					},
 				)
+			}
 
-			return sub.Unsubscribe
+			subscriptions.AddUnsubscribable(
+				source.SubscribeWithContext(subscriberCtx, makeObserver(0)),
+			)
+			subscriptions.AddUnsubscribable(
+				obsB.SubscribeWithContext(subscriberCtx, makeObserver(1)),
+			)
+
+			return subscriptions.Unsubscribe
 		})
 	}
 }
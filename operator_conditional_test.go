@@ -15,6 +15,7 @@
 package ro
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -339,6 +340,106 @@ func TestOperatorConditionalDefaultIfEmpty(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorConditionalIsEmpty(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			IsEmpty[int](),
+		),
+	)
+	is.Equal([]bool{false}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Empty[int](),
+			IsEmpty[int](),
+		),
+	)
+	is.Equal([]bool{true}, values)
+	is.NoError(err)
+
+	// the answer is known on the first value, without waiting for completion
+	source := NewPublishSubject[int]()
+	output := []bool{}
+	completed := false
+
+	sub := Pipe1(
+		source.AsObservable(),
+		IsEmpty[int](),
+	).Subscribe(NewObserver(
+		func(value bool) { output = append(output, value) },
+		func(err error) {},
+		func() { completed = true },
+	))
+
+	source.Next(1)
+	is.Equal([]bool{false}, output)
+	is.True(completed)
+
+	sub.Unsubscribe()
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			IsEmpty[int](),
+		),
+	)
+	is.Equal([]bool{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorConditionalSwitchIfEmpty(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	fallbackSubscribed := false
+	fallback := DoOnSubscribe[int](func() {
+		fallbackSubscribed = true
+	})(Just(42, 43))
+
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			SwitchIfEmpty(fallback),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+	is.False(fallbackSubscribed)
+
+	values, err = Collect(
+		Pipe1(
+			Empty[int](),
+			SwitchIfEmpty(fallback),
+		),
+	)
+	is.Equal([]int{42, 43}, values)
+	is.NoError(err)
+	is.True(fallbackSubscribed)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			SwitchIfEmpty(Just(42)),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		Pipe1(
+			Empty[int](),
+			SwitchIfEmpty(Throw[int](assert.AnError)),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorConditionalSequenceEqual(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -364,19 +465,38 @@ func TestOperatorConditionalSequenceEqual(t *testing.T) {
 	values, err = Collect(
 		Pipe1(
 			Empty[int](),
-			SequenceEqual(Just(1, 2, 3)),
+			SequenceEqual(Empty[int]()),
 		),
 	)
 	is.Equal([]bool{true}, values)
 	is.NoError(err)
 
+	// mismatched lengths yield false
+	values, err = Collect(
+		Pipe1(
+			Empty[int](),
+			SequenceEqual(Just(1, 2, 3)),
+		),
+	)
+	is.Equal([]bool{false}, values)
+	is.NoError(err)
+
 	values, err = Collect(
 		Pipe1(
 			Just(1, 2, 3),
 			SequenceEqual(Empty[int]()),
 		),
 	)
-	is.Equal([]bool{true}, values)
+	is.Equal([]bool{false}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			SequenceEqual(Just(1, 2)),
+		),
+	)
+	is.Equal([]bool{false}, values)
 	is.NoError(err)
 
 	values, err = Collect(
@@ -397,3 +517,30 @@ func TestOperatorConditionalSequenceEqual(t *testing.T) {
 	is.Equal([]bool{}, values)
 	is.EqualError(err, assert.AnError.Error())
 }
+
+func TestOperatorConditionalSequenceEqualBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	caseInsensitive := func(a, b string) bool {
+		return strings.EqualFold(a, b)
+	}
+
+	values, err := Collect(
+		Pipe1(
+			Just("a", "B", "c"),
+			SequenceEqualBy(Just("A", "b", "C"), caseInsensitive),
+		),
+	)
+	is.Equal([]bool{true}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Just("a", "b"),
+			SequenceEqualBy(Just("a", "c"), caseInsensitive),
+		),
+	)
+	is.Equal([]bool{false}, values)
+	is.NoError(err)
+}
@@ -182,6 +182,11 @@ func ShareWithConfig[T any](config ShareConfig[T]) func(Observable[T]) Observabl
 
 // ShareReplayConfig is the configuration for the ShareReplay operator.
 type ShareReplayConfig struct {
+	// DisableResetOnError keeps the shared Observable connected after an
+	// error: late subscribers receive the buffered values followed by the
+	// error, without resubscribing upstream. The zero value resets on error,
+	// like ShareReplay.
+	DisableResetOnError bool
 	ResetOnRefCountZero bool
 }
 
@@ -217,6 +222,10 @@ func ShareReplay[T any](bufferSize int) func(Observable[T]) Observable[T] {
 // The configuration allows to customize the behavior of the shared
 // Observable:
 //   - `bufferSize` is the number of items to replay to future subscribers.
+//   - `DisableResetOnError` determines whether the shared Observable should be
+//     kept connected when an error is emitted. When set, late subscribers
+//     receive the buffered values followed by the error, without resubscribing
+//     upstream.
 //   - `ResetOnRefCountZero` determines whether the shared Observable should be
 //     reset when the reference count reaches zero.
 //
@@ -227,7 +236,7 @@ func ShareReplayWithConfig[T any](bufferSize int, config ShareReplayConfig) func
 			Connector: func() Subject[T] {
 				return NewReplaySubject[T](bufferSize)
 			},
-			ResetOnError:        true,
+			ResetOnError:        !config.DisableResetOnError,
 			ResetOnComplete:     false,
 			ResetOnRefCountZero: config.ResetOnRefCountZero,
 		},
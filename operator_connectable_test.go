@@ -738,5 +738,49 @@ func TestOperatorConnectableShareReplay_smallBuffer(t *testing.T) { //nolint:par
 }
 
 func TestOperatorConnectableShareReplayWithConfig(t *testing.T) { //nolint:paralleltest
-	// @TODO: implement
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// DisableResetOnError -> late subscribers receive the buffered values
+	// followed by the error, without resubscribing upstream.
+	subscriptions := 0
+	source := Pipe1(
+		Defer(func() Observable[int] {
+			subscriptions++
+			return ConcatWith(Throw[int](assert.AnError))(Just(1, 2))
+		}),
+		ShareReplayWithConfig[int](10, ShareReplayConfig{DisableResetOnError: true}),
+	)
+
+	values, err := Collect(source)
+	is.Equal([]int{1, 2}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.Equal(1, subscriptions)
+
+	values, err = Collect(source)
+	is.Equal([]int{1, 2}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.Equal(1, subscriptions)
+
+	// Zero value -> the upstream is resubscribed after an error, like
+	// ShareReplay.
+	subscriptions = 0
+	source = Pipe1(
+		Defer(func() Observable[int] {
+			subscriptions++
+			return ConcatWith(Throw[int](assert.AnError))(Just(1, 2))
+		}),
+		ShareReplayWithConfig[int](10, ShareReplayConfig{}),
+	)
+
+	values, err = Collect(source)
+	is.Equal([]int{1, 2}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.Equal(1, subscriptions)
+
+	values, err = Collect(source)
+	is.Equal([]int{1, 2}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.Equal(2, subscriptions)
 }
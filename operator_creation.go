@@ -17,10 +17,14 @@ package ro
 import (
 	"context"
 	"math"
+	"os"
+	"os/signal"
+	"runtime"
 	"time"
 
 	"github.com/samber/lo"
 	"github.com/samber/ro/internal/xrand"
+	"github.com/samber/ro/internal/xsync"
 )
 
 // Of creates an Observable that emits some values you specify.
@@ -114,6 +118,40 @@ func Interval(interval time.Duration) Observable[int64] {
 	})
 }
 
+// FromTicker creates an Observable that emits the ticks of an external
+// *time.Ticker. Unlike Interval, the ticker is owned by the caller: the
+// Observable stops reading from it on unsubscription, but never stops it.
+// This lets a single ticker be shared with existing ticker-based code. Note
+// that each tick is delivered to a single reader, so concurrent subscriptions
+// to the same ticker compete for the ticks.
+func FromTicker(t *time.Ticker) Observable[time.Time] {
+	return NewObservableWithContext(func(ctx context.Context, destination Observer[time.Time]) Teardown {
+		done := make(chan struct{})
+
+		go recoverUnhandledError(func() {
+			defer destination.CompleteWithContext(ctx)
+
+			for {
+				select {
+				case <-done:
+					return
+				case <-ctx.Done():
+					return
+				case tick, ok := <-t.C:
+					// `ok` is not expected to be false, because the go runtime will close the channel itself
+					if ok {
+						destination.NextWithContext(ctx, tick)
+					}
+				}
+			}
+		})
+
+		return func() {
+			close(done)
+		}
+	})
+}
+
 // IntervalWithInitial creates an Observable that emits an infinite sequence of ascending
 // integers, with a constant interval between them. The first value is not emitted immediately,
 // but after the initial interval has passed. The first interval is `initial`, and the subsequent
@@ -200,6 +238,48 @@ func Range(start, end int64) Observable[int64] {
 	})
 }
 
+// RangeWithYield creates an Observable that emits a range of integers, like
+// Range, but calls runtime.Gosched() every `yieldEvery` emissions. A giant
+// synchronous range feeding a synchronous pipeline would otherwise monopolize
+// its goroutine and starve time-based operators running in the same process.
+// The range is [start:end), so `start` is emitted but not `end`.
+// If `start` is equal to `end`, an empty Observable is returned.
+// If `start` is greater than `end`, the emitted values are in
+// descending order. The step is 1.
+// It panics if `yieldEvery` is lower than 1.
+func RangeWithYield(start, end int64, yieldEvery int) Observable[int64] {
+	if yieldEvery < 1 {
+		panic(ErrRangeWithYieldWrongYieldEvery)
+	}
+
+	sign := int64(1)
+
+	if start == end {
+		return Empty[int64]()
+	} else if start > end {
+		sign = -1
+	}
+
+	return NewUnsafeObservableWithContext(func(ctx context.Context, destination Observer[int64]) Teardown {
+		cursor := start
+		emitted := 0
+
+		for cursor*sign < end*sign {
+			destination.NextWithContext(ctx, cursor)
+			cursor += sign
+
+			emitted++
+			if emitted%yieldEvery == 0 {
+				runtime.Gosched()
+			}
+		}
+
+		destination.CompleteWithContext(ctx)
+
+		return nil
+	})
+}
+
 // RangeWithStep creates an Observable that emits a range of floats.
 // The range is [start:end), so `start` is emitted but not `end`.
 // If `start` is equal to `end`, an empty Observable is returned.
@@ -294,6 +374,36 @@ func RangeWithStepAndInterval(start, end, step float64, interval time.Duration)
 	)
 }
 
+// Generate creates an Observable that unfolds a state machine: starting from
+// the initial state, it emits selector(state) for every state accepted by
+// condition, then advances with iterate(state). A nil condition generates
+// forever. Generation runs on a dedicated goroutine and stops as soon as the
+// subscriber unsubscribes, so an infinite stream can be capped with Take.
+func Generate[S any, T any](initial S, condition func(state S) bool, iterate func(state S) S, selector func(state S) T) Observable[T] {
+	return NewObservableWithContext(func(ctx context.Context, destination Observer[T]) Teardown {
+		done := make(chan struct{})
+
+		go recoverUnhandledError(func() {
+			defer destination.CompleteWithContext(ctx)
+
+			for state := initial; condition == nil || condition(state); state = iterate(state) {
+				select {
+				case <-done:
+					return
+				case <-ctx.Done():
+					return
+				default:
+					destination.NextWithContext(ctx, selector(state))
+				}
+			}
+		})
+
+		return func() {
+			close(done)
+		}
+	})
+}
+
 // Repeat creates an Observable that emits a single value multiple times.
 // This is a creation operator. The pipeable equivalent is `RepeatWith`.
 // Play: https://go.dev/play/p/CUvh_TYALNe
@@ -363,6 +473,35 @@ func FromChannel[T any](in <-chan T) Observable[T] {
 	})
 }
 
+// FromSignal creates an Observable that emits the operating system signals
+// delivered to the process, among those listed. It never completes on its
+// own; the signal registration is removed on unsubscription, so no handler
+// leaks across tests.
+func FromSignal(signals ...os.Signal) Observable[os.Signal] {
+	return NewUnsafeObservableWithContext(func(ctx context.Context, destination Observer[os.Signal]) Teardown {
+		in := make(chan os.Signal, 1)
+		signal.Notify(in, signals...)
+
+		done := make(chan struct{})
+
+		go recoverUnhandledError(func() {
+			for {
+				select {
+				case sig := <-in:
+					destination.NextWithContext(ctx, sig)
+				case <-done:
+					return
+				}
+			}
+		})
+
+		return func() {
+			signal.Stop(in)
+			close(done)
+		}
+	})
+}
+
 // FromSlice creates an Observable from a slice. The values are emitted
 // in the order they are in the slice.
 // Play: https://go.dev/play/p/BNhnqoQn0tP
@@ -477,6 +616,435 @@ func Merge[T any](sources ...Observable[T]) Observable[T] {
 	return MergeAll[T]()(Just(sources...))
 }
 
+// MergeTagged merges the source Observables while retaining provenance: each
+// value is emitted paired with the tag of the source it came from, which plain
+// Merge erases. Terminal error and complete semantics match Merge.
+func MergeTagged[T any](sources map[string]Observable[T]) Observable[lo.Tuple2[string, T]] {
+	tagged := make([]Observable[lo.Tuple2[string, T]], 0, len(sources))
+
+	for tag, source := range sources {
+		tag := tag
+
+		tagged = append(tagged, Pipe1(
+			source,
+			Map(func(item T) lo.Tuple2[string, T] {
+				return lo.T2(tag, item)
+			}),
+		))
+	}
+
+	return Merge(tagged...)
+}
+
+// MergePriorityConfig configures the bounded buffering of MergePriorityWithConfig.
+type MergePriorityConfig struct {
+	// Capacity bounds the number of pending values buffered per source.
+	Capacity int
+	// OverflowStrategy decides what happens when a source's buffer is full:
+	// terminate with ErrMergePriorityOverflow, drop the oldest pending value,
+	// or drop the incoming one.
+	OverflowStrategy OverflowStrategy
+}
+
+// MergePriority merges the source Observables, giving earlier sources higher
+// priority: when values from multiple sources are pending, the value from the
+// highest-priority source is emitted first. It is useful for merging a
+// high-priority control stream with a bulk data stream while ensuring control
+// messages jump the queue. Each source's pending buffer is bounded to 1024
+// values and overflow terminates the stream; use MergePriorityWithConfig to
+// tune this.
+func MergePriority[T any](sources ...Observable[T]) Observable[T] {
+	return MergePriorityWithConfig(MergePriorityConfig{
+		Capacity:         1024,
+		OverflowStrategy: OverflowStrategyError,
+	}, sources...)
+}
+
+// MergePriorityWithConfig merges the source Observables, giving earlier
+// sources higher priority, with configurable bounded buffering. Priority only
+// matters while the consumer is busy: values arriving in the meantime are
+// buffered per source and drained highest-priority first. Any source error is
+// forwarded immediately and pending values are discarded. It completes when
+// every source has completed and every buffer has been drained. It panics if
+// the capacity is lower than 1.
+func MergePriorityWithConfig[T any](config MergePriorityConfig, sources ...Observable[T]) Observable[T] {
+	if config.Capacity < 1 {
+		panic(ErrMergePriorityWrongCapacity)
+	}
+
+	if len(sources) == 0 {
+		return Empty[T]()
+	}
+
+	return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+		mu := xsync.NewMutexWithSpinlock()
+		subscriptions := NewSubscription(nil)
+
+		queues := make([][]lo.Tuple2[context.Context, T], len(sources))
+		completed := make([]bool, len(sources))
+		var terminal *lo.Tuple2[context.Context, Notification[T]]
+		stopped := false
+
+		signal := make(chan struct{}, 1)
+		stop := make(chan struct{})
+
+		notify := func() {
+			select {
+			case signal <- struct{}{}:
+			default:
+			}
+		}
+
+		// must be called with mu held
+		fail := func(ctx context.Context, err error) {
+			notification := lo.T2(ctx, NewNotificationError[T](err))
+			terminal = &notification
+			stopped = true
+
+			for i := range queues {
+				queues[i] = nil
+			}
+		}
+
+		go func() {
+			for {
+				select {
+				case <-stop:
+					return
+				case <-signal:
+				}
+
+				for {
+					mu.Lock()
+
+					// highest-priority pending value first
+					index := -1
+
+					for i := range queues {
+						if len(queues[i]) > 0 {
+							index = i
+							break
+						}
+					}
+
+					if index == -1 {
+						if terminal != nil {
+							head := *terminal
+							mu.Unlock()
+							processNotificationWithObserverAndContext(head.A, head.B, destination)
+
+							return
+						}
+
+						mu.Unlock()
+						break
+					}
+
+					head := queues[index][0]
+					queues[index] = queues[index][1:]
+
+					mu.Unlock()
+
+					destination.NextWithContext(head.A, head.B)
+
+					if destination.IsClosed() {
+						return
+					}
+				}
+			}
+		}()
+
+		for i := range sources {
+			index := i
+
+			subscriptions.AddUnsubscribable(
+				sources[i].SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, value T) {
+							mu.Lock()
+
+							if stopped {
+								mu.Unlock()
+								return
+							}
+
+							if len(queues[index]) >= config.Capacity {
+								switch config.OverflowStrategy {
+								case OverflowStrategyDropOldest:
+									queues[index] = queues[index][1:]
+								case OverflowStrategyDropLatest:
+									mu.Unlock()
+									return
+								default:
+									// fail fast: pending values are discarded
+									fail(ctx, ErrMergePriorityOverflow)
+									mu.Unlock()
+									notify()
+
+									return
+								}
+							}
+
+							queues[index] = append(queues[index], lo.T2(ctx, value))
+
+							mu.Unlock()
+							notify()
+						},
+						func(ctx context.Context, err error) {
+							mu.Lock()
+
+							if !stopped {
+								fail(ctx, err)
+							}
+
+							mu.Unlock()
+							notify()
+						},
+						func(ctx context.Context) {
+							mu.Lock()
+
+							completed[index] = true
+
+							allDone := true
+
+							for i := range completed {
+								if !completed[i] {
+									allDone = false
+									break
+								}
+							}
+
+							if !stopped && allDone {
+								notification := lo.T2(ctx, NewNotificationComplete[T]())
+								terminal = &notification
+								stopped = true
+							}
+
+							mu.Unlock()
+							notify()
+						},
+					),
+				),
+			)
+		}
+
+		return func() {
+			subscriptions.Unsubscribe()
+
+			mu.Lock()
+
+			stopped = true
+
+			for i := range queues {
+				queues[i] = nil
+			}
+
+			mu.Unlock()
+
+			close(stop)
+		}
+	})
+}
+
+// RoundRobin merges the source Observables with round-robin fairness: values
+// are emitted one source at a time, in the order the sources are provided,
+// regardless of each source's pace. Values arriving out of turn are buffered
+// per source, so a fast source cannot starve a slow one. A source that
+// completes is skipped in subsequent turns, and the output completes when
+// every source has completed and every buffer has been drained. Any source
+// error is forwarded immediately.
+func RoundRobin[T any](sources ...Observable[T]) Observable[T] {
+	if len(sources) == 0 {
+		return Empty[T]()
+	}
+
+	return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+		mu := xsync.NewMutexWithSpinlock()
+		subscriptions := NewSubscription(nil)
+
+		queues := make([][]lo.Tuple2[context.Context, T], len(sources))
+		completed := make([]bool, len(sources))
+		turn := 0
+		done := false
+
+		// must be called with mu held
+		drain := func(ctx context.Context) {
+			for !done {
+				if len(queues[turn]) > 0 {
+					item := queues[turn][0]
+					queues[turn] = queues[turn][1:]
+					destination.NextWithContext(item.A, item.B)
+					turn = (turn + 1) % len(sources)
+
+					continue
+				}
+
+				if !completed[turn] {
+					// it is the current source's turn, but it has nothing
+					// buffered yet: hold back the other sources
+					return
+				}
+
+				allDone := true
+
+				for i := range sources {
+					if !completed[i] || len(queues[i]) > 0 {
+						allDone = false
+						break
+					}
+				}
+
+				if allDone {
+					done = true
+					destination.CompleteWithContext(ctx)
+
+					return
+				}
+
+				turn = (turn + 1) % len(sources)
+			}
+		}
+
+		for i := range sources {
+			index := i
+
+			subscriptions.AddUnsubscribable(
+				sources[i].SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, value T) {
+							mu.Lock()
+							queues[index] = append(queues[index], lo.T2(ctx, value))
+							drain(ctx)
+							mu.Unlock()
+						},
+						func(ctx context.Context, err error) {
+							mu.Lock()
+							if !done {
+								done = true
+								destination.ErrorWithContext(ctx, err)
+							}
+							mu.Unlock()
+						},
+						func(ctx context.Context) {
+							mu.Lock()
+							completed[index] = true
+							drain(ctx)
+							mu.Unlock()
+						},
+					),
+				),
+			)
+		}
+
+		return subscriptions.Unsubscribe
+	})
+}
+
+// InterleaveConfig configures the completion behavior of Interleave.
+type InterleaveConfig struct {
+	// ContinueOnComplete keeps interleaving the remaining sources when one of
+	// them completes, instead of completing the output.
+	ContinueOnComplete bool
+}
+
+// Interleave merges the source Observables by taking one element from each in
+// turn, in the order the sources are provided. Values arriving out of turn are
+// buffered per source. The output completes as soon as a source completes and
+// its turn comes around with nothing buffered, cutting every source to the
+// length of the shortest one; the remaining sources are then unsubscribed. Any
+// source error is forwarded immediately. See InterleaveWithConfig to keep
+// going with the remaining sources instead.
+func Interleave[T any](sources ...Observable[T]) Observable[T] {
+	return InterleaveWithConfig(InterleaveConfig{}, sources...)
+}
+
+// InterleaveWithConfig merges the source Observables by taking one element
+// from each in turn, with configurable completion behavior. With
+// ContinueOnComplete, a completed source is skipped in subsequent turns and
+// the output completes when every source has completed, like RoundRobin.
+func InterleaveWithConfig[T any](config InterleaveConfig, sources ...Observable[T]) Observable[T] {
+	if config.ContinueOnComplete {
+		return RoundRobin(sources...)
+	}
+
+	if len(sources) == 0 {
+		return Empty[T]()
+	}
+
+	return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+		mu := xsync.NewMutexWithSpinlock()
+		subscriptions := NewSubscription(nil)
+
+		queues := make([][]lo.Tuple2[context.Context, T], len(sources))
+		completed := make([]bool, len(sources))
+		turn := 0
+		done := false
+
+		// must be called with mu held
+		drain := func(ctx context.Context) {
+			for !done {
+				if len(queues[turn]) > 0 {
+					item := queues[turn][0]
+					queues[turn] = queues[turn][1:]
+					destination.NextWithContext(item.A, item.B)
+					turn = (turn + 1) % len(sources)
+
+					continue
+				}
+
+				if !completed[turn] {
+					// it is the current source's turn, but it has nothing
+					// buffered yet: hold back the other sources
+					return
+				}
+
+				// the current source has nothing left to contribute to this
+				// round: the interleaving is over
+				done = true
+				destination.CompleteWithContext(ctx)
+
+				return
+			}
+		}
+
+		for i := range sources {
+			index := i
+
+			subscriptions.AddUnsubscribable(
+				sources[i].SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, value T) {
+							mu.Lock()
+							queues[index] = append(queues[index], lo.T2(ctx, value))
+							drain(ctx)
+							mu.Unlock()
+						},
+						func(ctx context.Context, err error) {
+							mu.Lock()
+							if !done {
+								done = true
+								destination.ErrorWithContext(ctx, err)
+							}
+							mu.Unlock()
+						},
+						func(ctx context.Context) {
+							mu.Lock()
+							completed[index] = true
+							drain(ctx)
+							mu.Unlock()
+						},
+					),
+				),
+			)
+		}
+
+		return subscriptions.Unsubscribe
+	})
+}
+
 // CombineLatest2 combines the values from the source Observable with the latest
 // values from the other Observables. It will only emit when all Observables have
 // emitted at least one value. It completes when the source Observable completes.
@@ -508,6 +1076,32 @@ func CombineLatest5[A, B, C, D, E any](obsA Observable[A], obsB Observable[B], o
 	return CombineLatestWith4[A](obsB, obsC, obsD, obsE)(obsA)
 }
 
+// CombineLatestInto combines the latest values of two Observables with the
+// given combiner, avoiding the tuple-then-map boilerplate of CombineLatest2.
+// It will only emit when all Observables have emitted at least one value. It
+// completes when the source Observable completes.
+func CombineLatestInto[A, B, R any](obsA Observable[A], obsB Observable[B], combine func(a A, b B) R) Observable[R] {
+	return Pipe1(
+		CombineLatest2(obsA, obsB),
+		Map(func(item lo.Tuple2[A, B]) R {
+			return combine(item.A, item.B)
+		}),
+	)
+}
+
+// CombineLatestInto3 combines the latest values of three Observables with the
+// given combiner, avoiding the tuple-then-map boilerplate of CombineLatest3.
+// It will only emit when all Observables have emitted at least one value. It
+// completes when the source Observable completes.
+func CombineLatestInto3[A, B, C, R any](obsA Observable[A], obsB Observable[B], obsC Observable[C], combine func(a A, b B, c C) R) Observable[R] {
+	return Pipe1(
+		CombineLatest3(obsA, obsB, obsC),
+		Map(func(item lo.Tuple3[A, B, C]) R {
+			return combine(item.A, item.B, item.C)
+		}),
+	)
+}
+
 // CombineLatestAny combines the values from the source Observable with the latest
 // values from the other Observables. It will only emit when all Observables have
 // emitted at least one value. It completes when the source Observable completes.
@@ -516,6 +1110,152 @@ func CombineLatestAny(sources ...Observable[any]) Observable[[]any] {
 	return CombineLatestAllAny()(Just(sources...))
 }
 
+// ForkJoin waits for every source Observable to complete and then emits a
+// single slice holding the last value of each source, in the order the sources
+// are provided. Unlike CombineLatest, it never emits intermediate
+// combinations, making it the right tool for "wait for all requests" fan-in.
+// If any source completes without emitting, ForkJoin completes without
+// emitting. If any source errors, the error is forwarded immediately and the
+// remaining sources are unsubscribed.
+func ForkJoin[T any](sources ...Observable[T]) Observable[[]T] {
+	if len(sources) == 0 {
+		return Empty[[]T]()
+	}
+
+	return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
+		mu := xsync.NewMutexWithSpinlock()
+		subscriptions := NewSubscription(nil)
+
+		values := make([]T, len(sources))
+		hasValue := make([]bool, len(sources))
+		remaining := len(sources)
+		done := false
+
+		for i := range sources {
+			index := i
+
+			subscriptions.AddUnsubscribable(
+				sources[i].SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, value T) {
+							mu.Lock()
+							values[index] = value
+							hasValue[index] = true
+							mu.Unlock()
+						},
+						func(ctx context.Context, err error) {
+							mu.Lock()
+							if done {
+								mu.Unlock()
+								return
+							}
+
+							done = true
+							mu.Unlock()
+
+							destination.ErrorWithContext(ctx, err)
+							subscriptions.Unsubscribe()
+						},
+						func(ctx context.Context) {
+							mu.Lock()
+							if done {
+								mu.Unlock()
+								return
+							}
+
+							remaining--
+
+							// a source that completed empty makes the result
+							// empty: short-circuit instead of waiting
+							if remaining > 0 && hasValue[index] {
+								mu.Unlock()
+								return
+							}
+
+							done = true
+							all := remaining == 0 && hasValue[index]
+							mu.Unlock()
+
+							if all {
+								destination.NextWithContext(ctx, values)
+							}
+
+							destination.CompleteWithContext(ctx)
+							subscriptions.Unsubscribe()
+						},
+					),
+				),
+			)
+		}
+
+		return subscriptions.Unsubscribe
+	})
+}
+
+// ForkJoin2 waits for both source Observables to complete and then emits a
+// single tuple of their last values. See ForkJoin.
+func ForkJoin2[A, B any](obsA Observable[A], obsB Observable[B]) Observable[lo.Tuple2[A, B]] {
+	return Pipe1(
+		ForkJoin(asAnyObservable(obsA), asAnyObservable(obsB)),
+		Map(func(values []any) lo.Tuple2[A, B] {
+			return lo.T2(values[0].(A), values[1].(B)) //nolint:forcetypeassert
+		}),
+	)
+}
+
+// ForkJoin3 waits for all source Observables to complete and then emits a
+// single tuple of their last values. See ForkJoin.
+func ForkJoin3[A, B, C any](obsA Observable[A], obsB Observable[B], obsC Observable[C]) Observable[lo.Tuple3[A, B, C]] {
+	return Pipe1(
+		ForkJoin(asAnyObservable(obsA), asAnyObservable(obsB), asAnyObservable(obsC)),
+		Map(func(values []any) lo.Tuple3[A, B, C] {
+			return lo.T3(values[0].(A), values[1].(B), values[2].(C)) //nolint:forcetypeassert
+		}),
+	)
+}
+
+// ForkJoin4 waits for all source Observables to complete and then emits a
+// single tuple of their last values. See ForkJoin.
+func ForkJoin4[A, B, C, D any](obsA Observable[A], obsB Observable[B], obsC Observable[C], obsD Observable[D]) Observable[lo.Tuple4[A, B, C, D]] {
+	return Pipe1(
+		ForkJoin(asAnyObservable(obsA), asAnyObservable(obsB), asAnyObservable(obsC), asAnyObservable(obsD)),
+		Map(func(values []any) lo.Tuple4[A, B, C, D] {
+			return lo.T4(values[0].(A), values[1].(B), values[2].(C), values[3].(D)) //nolint:forcetypeassert
+		}),
+	)
+}
+
+// ForkJoin5 waits for all source Observables to complete and then emits a
+// single tuple of their last values. See ForkJoin.
+func ForkJoin5[A, B, C, D, E any](obsA Observable[A], obsB Observable[B], obsC Observable[C], obsD Observable[D], obsE Observable[E]) Observable[lo.Tuple5[A, B, C, D, E]] {
+	return Pipe1(
+		ForkJoin(asAnyObservable(obsA), asAnyObservable(obsB), asAnyObservable(obsC), asAnyObservable(obsD), asAnyObservable(obsE)),
+		Map(func(values []any) lo.Tuple5[A, B, C, D, E] {
+			return lo.T5(values[0].(A), values[1].(B), values[2].(C), values[3].(D), values[4].(E)) //nolint:forcetypeassert
+		}),
+	)
+}
+
+// ForkJoin6 waits for all source Observables to complete and then emits a
+// single tuple of their last values. See ForkJoin.
+func ForkJoin6[A, B, C, D, E, F any](obsA Observable[A], obsB Observable[B], obsC Observable[C], obsD Observable[D], obsE Observable[E], obsF Observable[F]) Observable[lo.Tuple6[A, B, C, D, E, F]] {
+	return Pipe1(
+		ForkJoin(asAnyObservable(obsA), asAnyObservable(obsB), asAnyObservable(obsC), asAnyObservable(obsD), asAnyObservable(obsE), asAnyObservable(obsF)),
+		Map(func(values []any) lo.Tuple6[A, B, C, D, E, F] {
+			return lo.T6(values[0].(A), values[1].(B), values[2].(C), values[3].(D), values[4].(E), values[5].(F)) //nolint:forcetypeassert
+		}),
+	)
+}
+
+// asAnyObservable erases the element type so heterogeneous sources can share
+// a single ForkJoin implementation.
+func asAnyObservable[T any](source Observable[T]) Observable[any] {
+	return Map(func(value T) any {
+		return value
+	})(source)
+}
+
 // Zip combines the values from the source Observable with the latest
 // values from the other Observables. It will only emit when all Observables have
 // emitted at least one value. It completes when the source Observable completes.
@@ -564,6 +1304,99 @@ func Zip6[A, B, C, D, E, F any](obsA Observable[A], obsB Observable[B], obsC Obs
 	return ZipWith5[A](obsB, obsC, obsD, obsE, obsF)(obsA)
 }
 
+// ZipLongest combines the values of the source Observables item by item like
+// Zip, but keeps emitting once a source has completed, substituting the fill
+// value for its missing items. It completes when every source has completed
+// and every buffer has been drained. Errors still short-circuit.
+func ZipLongest[T any](fill T, sources ...Observable[T]) Observable[[]T] {
+	return ZipAllLongest[T](fill)(Just(sources...))
+}
+
+// ZipLongest2 combines the values of two Observables pairwise like Zip2, but
+// keeps emitting once a source has completed, substituting its fill value for
+// the missing items. It completes when both sources have completed. Errors
+// still short-circuit.
+func ZipLongest2[A, B any](obsA Observable[A], obsB Observable[B], fillA A, fillB B) Observable[lo.Tuple2[A, B]] {
+	return Pipe1(
+		zipLongestAny(
+			[]any{fillA, fillB},
+			asAnyObservable(obsA),
+			asAnyObservable(obsB),
+		),
+		Map(func(items []any) lo.Tuple2[A, B] {
+			return lo.T2(items[0].(A), items[1].(B)) //nolint:errcheck,forcetypeassert
+		}),
+	)
+}
+
+// ZipLongest3 combines the values of three Observables item by item like Zip3,
+// but keeps emitting once a source has completed, substituting its fill value
+// for the missing items. It completes when all sources have completed. Errors
+// still short-circuit.
+func ZipLongest3[A, B, C any](obsA Observable[A], obsB Observable[B], obsC Observable[C], fillA A, fillB B, fillC C) Observable[lo.Tuple3[A, B, C]] {
+	return Pipe1(
+		zipLongestAny(
+			[]any{fillA, fillB, fillC},
+			asAnyObservable(obsA),
+			asAnyObservable(obsB),
+			asAnyObservable(obsC),
+		),
+		Map(func(items []any) lo.Tuple3[A, B, C] {
+			return lo.T3(items[0].(A), items[1].(B), items[2].(C)) //nolint:errcheck,forcetypeassert
+		}),
+	)
+}
+
+// ZipLongest4 combines the values of four Observables item by item like Zip4,
+// but keeps emitting once a source has completed, substituting its fill value
+// for the missing items. It completes when all sources have completed. Errors
+// still short-circuit.
+func ZipLongest4[A, B, C, D any](obsA Observable[A], obsB Observable[B], obsC Observable[C], obsD Observable[D], fillA A, fillB B, fillC C, fillD D) Observable[lo.Tuple4[A, B, C, D]] {
+	return Pipe1(
+		zipLongestAny(
+			[]any{fillA, fillB, fillC, fillD},
+			asAnyObservable(obsA),
+			asAnyObservable(obsB),
+			asAnyObservable(obsC),
+			asAnyObservable(obsD),
+		),
+		Map(func(items []any) lo.Tuple4[A, B, C, D] {
+			return lo.T4(items[0].(A), items[1].(B), items[2].(C), items[3].(D)) //nolint:errcheck,forcetypeassert
+		}),
+	)
+}
+
+// zipLongestAny zips type-erased sources with per-source fill values.
+func zipLongestAny(fills []any, sources ...Observable[any]) Observable[[]any] {
+	return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]any]) Teardown {
+		return zipLongestInnerSubscriptions(subscriberCtx, sources, fills, destination)
+	})
+}
+
+// ZipInto combines the values of two Observables pairwise with the given
+// combiner, avoiding the tuple-then-map boilerplate of Zip2. It completes
+// when any of the Observables completes.
+func ZipInto[A, B, R any](obsA Observable[A], obsB Observable[B], combine func(a A, b B) R) Observable[R] {
+	return Pipe1(
+		Zip2(obsA, obsB),
+		Map(func(item lo.Tuple2[A, B]) R {
+			return combine(item.A, item.B)
+		}),
+	)
+}
+
+// ZipInto3 combines the values of three Observables item by item with the
+// given combiner, avoiding the tuple-then-map boilerplate of Zip3. It
+// completes when any of the Observables completes.
+func ZipInto3[A, B, C, R any](obsA Observable[A], obsB Observable[B], obsC Observable[C], combine func(a A, b B, c C) R) Observable[R] {
+	return Pipe1(
+		Zip3(obsA, obsB, obsC),
+		Map(func(item lo.Tuple3[A, B, C]) R {
+			return combine(item.A, item.B, item.C)
+		}),
+	)
+}
+
 // Concat concatenates the source Observable with other Observables. It subscribes
 // to each inner Observable only after the previous one completes, maintaining their
 // order. It completes when all inner Observables are done.
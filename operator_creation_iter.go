@@ -0,0 +1,62 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+
+package ro
+
+import (
+	"context"
+	"iter"
+
+	"github.com/samber/lo"
+)
+
+// FromSeq creates an Observable from a range-over-func iterator. The iterator
+// is pulled lazily on subscription and iteration stops when the subscriber is
+// unsubscribed.
+func FromSeq[T any](seq iter.Seq[T]) Observable[T] {
+	return NewUnsafeObservableWithContext(func(ctx context.Context, destination Observer[T]) Teardown {
+		for value := range seq {
+			if destination.IsClosed() {
+				return nil
+			}
+
+			destination.NextWithContext(ctx, value)
+		}
+
+		destination.CompleteWithContext(ctx)
+
+		return nil
+	})
+}
+
+// FromSeq2 creates an Observable of key-value pairs from a range-over-func
+// iterator. The iterator is pulled lazily on subscription and iteration stops
+// when the subscriber is unsubscribed.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) Observable[lo.Tuple2[K, V]] {
+	return NewUnsafeObservableWithContext(func(ctx context.Context, destination Observer[lo.Tuple2[K, V]]) Teardown {
+		for k, v := range seq {
+			if destination.IsClosed() {
+				return nil
+			}
+
+			destination.NextWithContext(ctx, lo.T2(k, v))
+		}
+
+		destination.CompleteWithContext(ctx)
+
+		return nil
+	})
+}
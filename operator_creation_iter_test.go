@@ -0,0 +1,74 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+
+package ro
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperatorCreationFromSeq(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	seq := func(yield func(int) bool) {
+		for i := 1; i <= 3; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	values, err := Collect(FromSeq(seq))
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// a cold source replays on resubscription
+	values, err = Collect(FromSeq(seq))
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(FromSeq(func(yield func(int) bool) {}))
+	is.Equal([]int{}, values)
+	is.NoError(err)
+}
+
+func TestOperatorCreationFromSeq2(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	seq := func(yield func(string, int) bool) {
+		for i, k := range []string{"a", "b", "c"} {
+			if !yield(k, i) {
+				return
+			}
+		}
+	}
+
+	values, err := Collect(FromSeq2(seq))
+	is.Equal([]lo.Tuple2[string, int]{lo.T2("a", 0), lo.T2("b", 1), lo.T2("c", 2)}, values)
+	is.NoError(err)
+
+	values, err = Collect(FromSeq2(func(yield func(string, int) bool) {}))
+	is.Equal([]lo.Tuple2[string, int]{}, values)
+	is.NoError(err)
+}
@@ -15,7 +15,10 @@
 package ro
 
 import (
+	"fmt"
+	"os"
 	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
@@ -137,6 +140,38 @@ func TestOperatorCreationInterval(t *testing.T) { //nolint:paralleltest
 	})
 }
 
+func TestOperatorCreationFromTicker(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	start := time.Now()
+
+	values, err := Collect(
+		Pipe1(
+			FromTicker(ticker),
+			Take[time.Time](3),
+		),
+	)
+	is.NoError(err)
+	is.Len(values, 3)
+	is.InDelta(150*time.Millisecond, time.Since(start), float64(50*time.Millisecond))
+
+	for _, tick := range values {
+		is.WithinDuration(time.Now(), tick, 500*time.Millisecond)
+	}
+
+	// the ticker is externally owned and still running after unsubscription
+	select {
+	case <-ticker.C:
+	case <-time.After(200 * time.Millisecond):
+		is.Fail("ticker should not have been stopped")
+	}
+}
+
 func TestOperatorCreationIntervalWithInitial(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 400*time.Millisecond)
@@ -210,6 +245,34 @@ func TestOperatorCreationRange(t *testing.T) {
 	is.NoError(err)
 }
 
+func TestOperatorCreationRangeWithYield(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	values, err := Collect(
+		RangeWithYield(1, 5, 2),
+	)
+	is.Equal([]int64{1, 2, 3, 4}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		RangeWithYield(5, 5, 1),
+	)
+	is.Equal([]int64{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		RangeWithYield(5, 1, 100),
+	)
+	is.Equal([]int64{5, 4, 3, 2}, values)
+	is.NoError(err)
+
+	is.PanicsWithError(ErrRangeWithYieldWrongYieldEvery.Error(), func() {
+		RangeWithYield(1, 5, 0)
+	})
+}
+
 func TestOperatorCreationRangeWithStep(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -302,6 +365,48 @@ func TestOperatorCreationRangeWithStepAndInterval(t *testing.T) {
 	is.NoError(err)
 }
 
+func TestOperatorCreationGenerate(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Generate(
+			1,
+			func(state int) bool { return state <= 5 },
+			func(state int) int { return state + 1 },
+			func(state int) int { return state * 10 },
+		),
+	)
+	is.Equal([]int{10, 20, 30, 40, 50}, values)
+	is.NoError(err)
+
+	// the initial state is rejected
+	values, err = Collect(
+		Generate(
+			10,
+			func(state int) bool { return state < 10 },
+			func(state int) int { return state + 1 },
+			func(state int) int { return state },
+		),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	// infinite fibonacci, capped by Take
+	values, err = Collect(Pipe1(
+		Generate(
+			lo.T2(0, 1),
+			nil,
+			func(state lo.Tuple2[int, int]) lo.Tuple2[int, int] { return lo.T2(state.B, state.A+state.B) },
+			func(state lo.Tuple2[int, int]) int { return state.A },
+		),
+		Take[int](8),
+	))
+	is.Equal([]int{0, 1, 1, 2, 3, 5, 8, 13}, values)
+	is.NoError(err)
+}
+
 func TestOperatorCreationRepeat(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -448,6 +553,34 @@ func TestOperatorCreationFromChannel(t *testing.T) { //nolint:paralleltest
 	})
 }
 
+func TestOperatorCreationFromSignal(t *testing.T) { //nolint:paralleltest
+	// t.Parallel() // signal delivery is process-wide
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	sync := lo.Synchronize()
+	output := []os.Signal{}
+
+	// registration happens synchronously on subscription, so the signal
+	// raised right after cannot be missed
+	sub := Pipe1(
+		FromSignal(syscall.SIGUSR1),
+		Take[os.Signal](1),
+	).Subscribe(OnNext(func(sig os.Signal) {
+		sync.Do(func() {
+			output = append(output, sig)
+		})
+	}))
+
+	is.NoError(syscall.Kill(syscall.Getpid(), syscall.SIGUSR1))
+
+	sub.Wait()
+	is.True(sub.IsClosed())
+	sync.Do(func() {
+		is.Equal([]os.Signal{syscall.SIGUSR1}, output)
+	})
+}
+
 func TestOperatorCreationFromSlice(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -640,6 +773,341 @@ func TestOperatorCreationMerge(t *testing.T) { //nolint:paralleltest
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorCreationMergeTagged(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		MergeTagged(map[string]Observable[int]{
+			"a": Just(1, 2),
+			"b": Just(3),
+		}),
+	)
+	is.ElementsMatch([]lo.Tuple2[string, int]{
+		lo.T2("a", 1),
+		lo.T2("a", 2),
+		lo.T2("b", 3),
+	}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MergeTagged(map[string]Observable[int]{}),
+	)
+	is.Equal([]lo.Tuple2[string, int]{}, values)
+	is.NoError(err)
+
+	_, err = Collect(
+		MergeTagged(map[string]Observable[int]{
+			"a": Throw[int](assert.AnError),
+		}),
+	)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorCreationRoundRobin(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// cold sources of different lengths interleave fairly
+	values, err := Collect(
+		RoundRobin(
+			Just(1, 3, 5),
+			Just(2, 4),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 4, 5}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		RoundRobin[int](),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		RoundRobin(Throw[int](assert.AnError), Just(1)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// a fast source is buffered while waiting for the slow one's turn
+	a := NewPublishSubject[string]()
+	b := NewPublishSubject[string]()
+
+	output := []string{}
+	completed := false
+
+	sub := RoundRobin(a.AsObservable(), b.AsObservable()).Subscribe(NewObserver(
+		func(value string) { output = append(output, value) },
+		func(err error) {},
+		func() { completed = true },
+	))
+
+	b.Next("b1") // buffered: it is a's turn
+	b.Next("b2")
+	is.Equal([]string{}, output)
+
+	a.Next("a1")
+	is.Equal([]string{"a1", "b1"}, output)
+
+	a.Next("a2")
+	is.Equal([]string{"a1", "b1", "a2", "b2"}, output)
+
+	a.Complete()
+	b.Next("b3")
+	is.Equal([]string{"a1", "b1", "a2", "b2", "b3"}, output)
+
+	b.Complete()
+	is.True(completed)
+
+	sub.Unsubscribe()
+}
+
+func TestOperatorCreationInterleave(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// the output is cut to the length of the shortest source
+	values, err := Collect(
+		Interleave(
+			Just(1, 4),
+			Just(2, 5, 7),
+			Just(3, 6),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 4, 5, 6}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Interleave[int](),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	// an empty source completes the output on its first turn
+	values, err = Collect(
+		Interleave(Just(1, 2), Empty[int]()),
+	)
+	is.Equal([]int{1}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Interleave(Throw[int](assert.AnError), Just(1)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// a fast source is buffered while waiting for the slow one's turn
+	a := NewPublishSubject[string]()
+	b := NewPublishSubject[string]()
+
+	output := []string{}
+	completed := false
+
+	sub := Interleave(a.AsObservable(), b.AsObservable()).Subscribe(NewObserver(
+		func(value string) { output = append(output, value) },
+		func(err error) {},
+		func() { completed = true },
+	))
+
+	b.Next("b1") // buffered: it is a's turn
+	is.Equal([]string{}, output)
+
+	a.Next("a1")
+	is.Equal([]string{"a1", "b1"}, output)
+
+	a.Complete()
+	is.True(completed)
+
+	sub.Unsubscribe()
+}
+
+func TestOperatorCreationInterleaveWithConfig(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// ContinueOnComplete keeps interleaving the remaining sources
+	values, err := Collect(
+		InterleaveWithConfig(
+			InterleaveConfig{ContinueOnComplete: true},
+			Just(1, 4),
+			Just(2, 5, 7),
+			Just(3, 6),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 4, 5, 6, 7}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		InterleaveWithConfig(
+			InterleaveConfig{},
+			Just(1, 4),
+			Just(2, 5, 7),
+			Just(3, 6),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 4, 5, 6}, values)
+	is.NoError(err)
+}
+
+func TestOperatorCreationMergePriority(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	// cold sources are drained in priority order
+	values, err := Collect(
+		MergePriority(
+			Just(1, 2),
+			Just(3, 4),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 4}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MergePriority[int](),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MergePriority(Throw[int](assert.AnError), Just(1)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrMergePriorityWrongCapacity.Error(), func() {
+		MergePriorityWithConfig(MergePriorityConfig{Capacity: 0}, Just(1))
+	})
+
+	// while the consumer is busy, pending control messages jump the queue
+	control := NewPublishSubject[string]()
+	data := NewPublishSubject[string]()
+
+	mu := lo.Synchronize()
+	output := []string{}
+	release := make(chan struct{})
+
+	sub := MergePriority(control.AsObservable(), data.AsObservable()).Subscribe(NewObserver(
+		func(value string) {
+			mu.Do(func() { output = append(output, value) })
+
+			if value == "d1" {
+				// hold the consumer so the next values pile up
+				<-release
+			}
+		},
+		func(err error) {},
+		func() {},
+	))
+
+	data.Next("d1")
+
+	for {
+		seen := false
+		mu.Do(func() { seen = len(output) == 1 })
+
+		if seen {
+			break
+		}
+
+		time.Sleep(1 * time.Millisecond)
+	}
+
+	data.Next("d2")
+	data.Next("d3")
+	control.Next("c1")
+	close(release)
+
+	control.Complete()
+	data.Complete()
+	sub.Wait()
+
+	mu.Do(func() {
+		is.Equal([]string{"d1", "c1", "d2", "d3"}, output)
+	})
+}
+
+func TestOperatorCreationMergePriorityWithConfig(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	run := func(strategy OverflowStrategy) ([]string, error) {
+		source := NewPublishSubject[string]()
+
+		mu := lo.Synchronize()
+		output := []string{}
+		release := make(chan struct{})
+
+		var thrown error
+
+		sub := MergePriorityWithConfig(
+			MergePriorityConfig{Capacity: 1, OverflowStrategy: strategy},
+			source.AsObservable(),
+		).Subscribe(NewObserver(
+			func(value string) {
+				mu.Do(func() { output = append(output, value) })
+
+				if value == "d1" {
+					<-release
+				}
+			},
+			func(err error) {
+				mu.Do(func() { thrown = err })
+			},
+			func() {},
+		))
+
+		source.Next("d1")
+
+		for {
+			seen := false
+			mu.Do(func() { seen = len(output) == 1 })
+
+			if seen {
+				break
+			}
+
+			time.Sleep(1 * time.Millisecond)
+		}
+
+		// the buffer holds a single pending value: d3 overflows
+		source.Next("d2")
+		source.Next("d3")
+		close(release)
+
+		source.Complete()
+		sub.Wait()
+
+		var values []string
+
+		mu.Do(func() {
+			values = output
+		})
+
+		var err error
+
+		mu.Do(func() { err = thrown })
+
+		return values, err
+	}
+
+	values, err := run(OverflowStrategyDropOldest)
+	is.Equal([]string{"d1", "d3"}, values)
+	is.NoError(err)
+
+	values, err = run(OverflowStrategyDropLatest)
+	is.Equal([]string{"d1", "d2"}, values)
+	is.NoError(err)
+
+	values, err = run(OverflowStrategyError)
+	is.Equal([]string{"d1"}, values)
+	is.ErrorIs(err, ErrMergePriorityOverflow)
+}
+
 func TestOperatorCreationCombineLatest2(t *testing.T) { //nolint:paralleltest
 	// @TODO
 }
@@ -656,10 +1124,198 @@ func TestOperatorCreationCombineLatest5(t *testing.T) { //nolint:paralleltest
 	// @TODO
 }
 
+func TestOperatorCreationCombineLatestInto(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		CombineLatestInto(
+			Of(42),
+			Of("42"),
+			func(a int, b string) string {
+				return fmt.Sprintf("%d-%s", a, b)
+			},
+		),
+	)
+	is.Equal([]string{"42-42"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CombineLatestInto3(
+			Of(1),
+			Of("2"),
+			Of(true),
+			func(a int, b string, c bool) string {
+				return fmt.Sprintf("%d-%s-%t", a, b, c)
+			},
+		),
+	)
+	is.Equal([]string{"1-2-true"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CombineLatestInto(
+			Throw[int](assert.AnError),
+			Of("42"),
+			func(a int, b string) string { return "" },
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorCreationCombineLatestAny(t *testing.T) { //nolint:paralleltest
 	// @TODO: implement
 }
 
+func TestOperatorCreationForkJoin(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// emits a single slice of final values once every source completed
+	values, err := Collect(
+		ForkJoin(
+			Just(1, 2, 3),
+			Just(4),
+			Just(5, 6),
+		),
+	)
+	is.Equal([][]int{{3, 4, 6}}, values)
+	is.NoError(err)
+
+	// a source that completes empty makes the result empty
+	values, err = Collect(
+		ForkJoin(
+			Just(1, 2, 3),
+			Empty[int](),
+		),
+	)
+	is.Equal([][]int{}, values)
+	is.NoError(err)
+
+	// an error short-circuits
+	values, err = Collect(
+		ForkJoin(
+			Just(1, 2, 3),
+			Throw[int](assert.AnError),
+		),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// no sources
+	values, err = Collect(
+		ForkJoin[int](),
+	)
+	is.Equal([][]int{}, values)
+	is.NoError(err)
+}
+
+func TestOperatorCreationForkJoin2(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ForkJoin2(
+			Just(1, 2),
+			Just("a", "b", "c"),
+		),
+	)
+	is.Equal([]lo.Tuple2[int, string]{lo.T2(2, "c")}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ForkJoin2(
+			Just(1, 2),
+			Empty[string](),
+		),
+	)
+	is.Equal([]lo.Tuple2[int, string]{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ForkJoin2(
+			Just(1, 2),
+			Throw[string](assert.AnError),
+		),
+	)
+	is.Equal([]lo.Tuple2[int, string]{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorCreationForkJoin3(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ForkJoin3(
+			Just(1, 2),
+			Just("a", "b"),
+			Just(true),
+		),
+	)
+	is.Equal([]lo.Tuple3[int, string, bool]{lo.T3(2, "b", true)}, values)
+	is.NoError(err)
+}
+
+func TestOperatorCreationForkJoin4(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ForkJoin4(
+			Just(1),
+			Just("a"),
+			Just(true),
+			Just(4.2),
+		),
+	)
+	is.Equal([]lo.Tuple4[int, string, bool, float64]{lo.T4(1, "a", true, 4.2)}, values)
+	is.NoError(err)
+}
+
+func TestOperatorCreationForkJoin5(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ForkJoin5(
+			Just(1),
+			Just("a"),
+			Just(true),
+			Just(4.2),
+			Just([]int{1}),
+		),
+	)
+	is.Equal([]lo.Tuple5[int, string, bool, float64, []int]{lo.T5(1, "a", true, 4.2, []int{1})}, values)
+	is.NoError(err)
+}
+
+func TestOperatorCreationForkJoin6(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ForkJoin6(
+			Just(1),
+			Just("a"),
+			Just(true),
+			Just(4.2),
+			Just([]int{1}),
+			Just('x'),
+		),
+	)
+	is.Equal([]lo.Tuple6[int, string, bool, float64, []int, rune]{lo.T6(1, "a", true, 4.2, []int{1}, 'x')}, values)
+	is.NoError(err)
+}
+
 func TestOperatorCreationZip(t *testing.T) { //nolint:paralleltest
 	// @TODO: implement
 }
@@ -684,6 +1340,124 @@ func TestOperatorCreationZip6(t *testing.T) { //nolint:paralleltest
 	// @TODO: implement
 }
 
+func TestOperatorCreationZipLongest(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// the shorter source is padded with the fill value
+	values, err := Collect(
+		ZipLongest(0, Just(1, 2, 3), Just(4, 5)),
+	)
+	is.Equal([][]int{{1, 4}, {2, 5}, {3, 0}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ZipLongest(0, Just(1, 2), Just(3, 4)),
+	)
+	is.Equal([][]int{{1, 3}, {2, 4}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ZipLongest(0, Just(1, 2), Empty[int]()),
+	)
+	is.Equal([][]int{{1, 0}, {2, 0}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ZipLongest[int](0),
+	)
+	is.Equal([][]int{}, values)
+	is.NoError(err)
+
+	// errors still short-circuit
+	values, err = Collect(
+		ZipLongest(0, Just(1), Throw[int](assert.AnError)),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorCreationZipLongest2(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ZipLongest2(Just(1, 2, 3), Just("a"), 0, "z"),
+	)
+	is.Equal([]lo.Tuple2[int, string]{lo.T2(1, "a"), lo.T2(2, "z"), lo.T2(3, "z")}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ZipLongest2(Just(1), Throw[string](assert.AnError), 0, "z"),
+	)
+	is.Equal([]lo.Tuple2[int, string]{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorCreationZipLongest3(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ZipLongest3(Just(1, 2), Just("a"), Just(true, false, true), 0, "z", false),
+	)
+	is.Equal([]lo.Tuple3[int, string, bool]{
+		lo.T3(1, "a", true),
+		lo.T3(2, "z", false),
+		lo.T3(0, "z", true),
+	}, values)
+	is.NoError(err)
+}
+
+func TestOperatorCreationZipLongest4(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ZipLongest4(Just(1, 2), Just("a"), Just(true), Just(4.2, 5.3), 0, "z", false, 0.0),
+	)
+	is.Equal([]lo.Tuple4[int, string, bool, float64]{
+		lo.T4(1, "a", true, 4.2),
+		lo.T4(2, "z", false, 5.3),
+	}, values)
+	is.NoError(err)
+}
+
+func TestOperatorCreationZipInto(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ZipInto(
+			Just(1, 2, 3),
+			Just("a", "b"),
+			func(a int, b string) string {
+				return fmt.Sprintf("%d%s", a, b)
+			},
+		),
+	)
+	is.Equal([]string{"1a", "2b"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ZipInto3(
+			Just(1, 2),
+			Just("a", "b"),
+			Just(true, false),
+			func(a int, b string, c bool) string {
+				return fmt.Sprintf("%d%s%t", a, b, c)
+			},
+		),
+	)
+	is.Equal([]string{"1atrue", "2bfalse"}, values)
+	is.NoError(err)
+}
+
 func TestOperatorCreationConcat(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
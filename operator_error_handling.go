@@ -16,8 +16,12 @@ package ro
 
 import (
 	"context"
+	"math"
+	"math/rand"
 	"sync/atomic"
 	"time"
+
+	"github.com/samber/ro/internal/xerrors"
 )
 
 // Catch catches errors on the observable to be handled by returning a new observable
@@ -48,6 +52,50 @@ func Catch[T any](finally func(err error) Observable[T]) func(Observable[T]) Obs
 	}
 }
 
+// CatchWith catches errors on the observable like Catch, but also passes an
+// attempt counter to the handler: 1 on the first error, incremented every time
+// an observable returned by the handler errors in turn. This lets the handler
+// retry (by returning the source again), fall back after a few attempts, or
+// rethrow — returning nil propagates the error downstream. The counter is
+// scoped to the subscription and resets on fresh subscription.
+func CatchWith[T any](handler func(err error, retryCount int) Observable[T]) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			subscriptions := NewSubscription(nil)
+			retryCount := 0
+
+			var subscribeTo func(ctx context.Context, obs Observable[T])
+
+			subscribeTo = func(ctx context.Context, obs Observable[T]) {
+				subscriptions.AddUnsubscribable(
+					obs.SubscribeWithContext(
+						ctx,
+						NewObserverWithContext(
+							destination.NextWithContext,
+							func(ctx context.Context, err error) {
+								retryCount++
+
+								next := handler(err, retryCount)
+								if next == nil {
+									destination.ErrorWithContext(ctx, err)
+									return
+								}
+
+								subscribeTo(ctx, next)
+							},
+							destination.CompleteWithContext,
+						),
+					),
+				)
+			}
+
+			subscribeTo(subscriberCtx, source)
+
+			return subscriptions.Unsubscribe
+		})
+	}
+}
+
 // OnErrorResumeNextWith instructs an Observable to begin emitting a second
 // Observable sequence if it encounters an error or completes. It immediately
 // subscribes to the next one that was passed.
@@ -128,6 +176,30 @@ func OnErrorReturn[T any](finally T) func(Observable[T]) Observable[T] {
 	}
 }
 
+// OnErrorReturnBy instructs an Observable to emit a replacement item computed
+// from the error it encounters. It will then complete the sequence. Unlike
+// OnErrorReturn, the fallback value can reflect what went wrong (e.g. a
+// default struct embedding the error message).
+func OnErrorReturnBy[T any](finally func(err error) T) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					destination.NextWithContext,
+					func(ctx context.Context, err error) {
+						destination.NextWithContext(ctx, finally(err))
+						destination.CompleteWithContext(ctx)
+					},
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // Retry resubscribes to the source observable when it encounters an error.
 // It will retry infinitely. If you want to limit the number of retries, use
 // RetryWithConfig.
@@ -145,20 +217,49 @@ type RetryConfig struct {
 	MaxRetries     uint64
 	Delay          time.Duration
 	ResetOnSuccess bool
+	// BackoffFactor multiplies the delay after each failed attempt:
+	// delay_n = Delay * BackoffFactor^n. Values 0 and 1 keep the delay fixed.
+	BackoffFactor float64
+	// MaxDelay caps the delay computed with BackoffFactor. The zero value
+	// disables the cap.
+	MaxDelay time.Duration
+	// Jitter randomizes each delay by ±delay*Jitter, with Jitter between 0
+	// and 1. The zero value disables jitter.
+	Jitter float64
+	// ShouldRetry is consulted before retrying: when it returns false, the
+	// error is forwarded downstream immediately. When nil, all errors are
+	// retried.
+	ShouldRetry func(err error) bool
+	// AggregateErrors emits, on final failure, an error wrapping the errors of
+	// all attempts via errors.Join, instead of only the last one.
+	AggregateErrors bool
 }
 
 // RetryWithConfig resubscribes to the source observable when it encounters
 // an error. If a max number of retries is set, it will retry until the max
-// number of retries is reached. If a delay is set, it will wait before retrying.
-// If resetOnSuccess is set, it will reset the number of retries when a value is
-// emitted.
+// number of retries is reached. If a delay is set, it will wait before retrying,
+// growing the delay with BackoffFactor up to MaxDelay and randomizing it with
+// Jitter when configured. If resetOnSuccess is set, it will reset the number of
+// retries when a value is emitted. Non-retryable errors can be rejected with
+// ShouldRetry. With AggregateErrors, the final error wraps the full failure
+// history instead of only the last attempt.
 // Play: https://go.dev/play/p/GilWi5xG0lr
 func RetryWithConfig[T any](opts RetryConfig) func(Observable[T]) Observable[T] {
+	if opts.BackoffFactor != 0 && opts.BackoffFactor < 1 {
+		panic(ErrRetryWithConfigWrongBackoffFactor)
+	}
+
+	if opts.Jitter < 0 || opts.Jitter > 1 {
+		panic(ErrRetryWithConfigWrongJitter)
+	}
+
 	return func(source Observable[T]) Observable[T] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
 			subscriptions := NewSubscription(nil)
 			retries := uint64(0)
 
+			var attemptErrors []error
+
 			for !subscriptions.IsClosed() {
 				// Check for context cancellation before retrying
 				select {
@@ -177,13 +278,18 @@ func RetryWithConfig[T any](opts RetryConfig) func(Observable[T]) Observable[T]
 						func(ctx context.Context, value T) {
 							if opts.ResetOnSuccess {
 								retries = 0
+								attemptErrors = nil
 							}
 							destination.NextWithContext(ctx, value)
 						},
 						func(ctx context.Context, err error) {
 							lastErr = err
+							if opts.AggregateErrors {
+								attemptErrors = append(attemptErrors, err)
+							}
 							retries++
-							shouldRetry = opts.MaxRetries == 0 || retries <= opts.MaxRetries
+							shouldRetry = (opts.MaxRetries == 0 || retries <= opts.MaxRetries) &&
+								(opts.ShouldRetry == nil || opts.ShouldRetry(err))
 						},
 						func(ctx context.Context) {
 							destination.CompleteWithContext(ctx)
@@ -196,10 +302,10 @@ func RetryWithConfig[T any](opts RetryConfig) func(Observable[T]) Observable[T]
 
 				if lastErr != nil {
 					if shouldRetry {
-						if opts.Delay > 0 {
+						if delay := retryDelay(opts, retries); delay > 0 {
 							// Use context-aware sleep that can be cancelled
 							select {
-							case <-time.After(opts.Delay):
+							case <-time.After(delay):
 								// Continue to next iteration
 							case <-subscriberCtx.Done():
 								destination.ErrorWithContext(subscriberCtx, subscriberCtx.Err())
@@ -209,6 +315,12 @@ func RetryWithConfig[T any](opts RetryConfig) func(Observable[T]) Observable[T]
 						// Continue to next iteration
 						continue
 					}
+
+					if opts.AggregateErrors {
+						// errors.Join has been introduced in go 1.20
+						lastErr = xerrors.Join(attemptErrors...)
+					}
+
 					destination.ErrorWithContext(subscriberCtx, lastErr)
 				}
 				break
@@ -219,6 +331,186 @@ func RetryWithConfig[T any](opts RetryConfig) func(Observable[T]) Observable[T]
 	}
 }
 
+// retryDelay computes the sleep before the nth retry (n starting at 1), by
+// scaling RetryConfig.Delay with the backoff factor, capping it at MaxDelay
+// and applying jitter.
+func retryDelay(opts RetryConfig, retries uint64) time.Duration {
+	if opts.Delay <= 0 {
+		return 0
+	}
+
+	delay := float64(opts.Delay)
+	if opts.BackoffFactor > 1 {
+		delay *= math.Pow(opts.BackoffFactor, float64(retries-1))
+	}
+
+	if opts.MaxDelay > 0 && delay > float64(opts.MaxDelay) {
+		delay = float64(opts.MaxDelay)
+	}
+
+	if opts.Jitter > 0 {
+		delay *= 1 + (rand.Float64()*2-1)*opts.Jitter //nolint:gosec
+	}
+
+	if delay > float64(math.MaxInt64) {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(delay)
+}
+
+// RetryUntil resubscribes to the source observable on error until the given
+// deadline, measured from the first subscription, has elapsed. Unlike Retry,
+// the bound is total elapsed time rather than attempt count, which composes
+// with variable backoff where the number of attempts is unpredictable. Once
+// the deadline is exceeded, the last error is forwarded.
+func RetryUntil[T any](deadline time.Duration) func(Observable[T]) Observable[T] {
+	if deadline <= 0 {
+		panic(ErrRetryUntilWrongDeadline)
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			subscriptions := NewSubscription(nil)
+			start := time.Now()
+
+			for !subscriptions.IsClosed() {
+				// Check for context cancellation before retrying
+				select {
+				case <-subscriberCtx.Done():
+					destination.ErrorWithContext(subscriberCtx, subscriberCtx.Err())
+					return subscriptions.Unsubscribe
+				default:
+				}
+
+				var lastErr error
+
+				sub := source.SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						destination.NextWithContext,
+						func(ctx context.Context, err error) {
+							lastErr = err
+						},
+						destination.CompleteWithContext,
+					),
+				)
+
+				subscriptions.AddUnsubscribable(sub)
+				sub.Wait()
+
+				if lastErr != nil {
+					if time.Since(start) < deadline {
+						continue
+					}
+
+					destination.ErrorWithContext(subscriberCtx, lastErr)
+				}
+
+				break
+			}
+
+			return subscriptions.Unsubscribe
+		})
+	}
+}
+
+// RetryWhen resubscribes to the source observable based on a notifier stream.
+// On source error, the error is emitted into the stream handed to the notifier
+// factory; each value emitted by the notifier triggers a resubscription. When
+// the notifier completes, the output completes; when the notifier errors, the
+// error is forwarded. This allows custom retry strategies such as exponential
+// backoff with jitter, retrying only on specific error types, or capping
+// retries with an external signal.
+//
+// The previous subscription is always terminated before resubscribing.
+func RetryWhen[T any](notifier func(errs Observable[error]) Observable[any]) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			subscriptions := NewSubscription(nil)
+
+			errs := NewPublishSubject[error]()
+
+			retrySignal := make(chan struct{}, 1)
+			notifierDone := make(chan error, 1) // nil means the notifier completed
+
+			subscriptions.AddUnsubscribable(
+				notifier(errs.AsObservable()).SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, _ any) {
+							select {
+							case retrySignal <- struct{}{}:
+							default:
+							}
+						},
+						func(ctx context.Context, err error) {
+							notifierDone <- err
+						},
+						func(ctx context.Context) {
+							notifierDone <- nil
+						},
+					),
+				),
+			)
+
+			for !subscriptions.IsClosed() {
+				var lastErr error
+
+				lastErrCtx := subscriberCtx
+
+				sub := source.SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						destination.NextWithContext,
+						func(ctx context.Context, err error) {
+							lastErr = err
+							lastErrCtx = ctx
+						},
+						destination.CompleteWithContext,
+					),
+				)
+
+				subscriptions.AddUnsubscribable(sub)
+				sub.Wait()
+
+				if lastErr == nil {
+					// completed normally, already forwarded downstream
+					break
+				}
+
+				errs.NextWithContext(lastErrCtx, lastErr)
+
+				// a notifier emission takes precedence over a simultaneous
+				// terminal notification (e.g. Take emitting then completing)
+				select {
+				case <-retrySignal:
+					continue
+				default:
+				}
+
+				select {
+				case <-retrySignal:
+					continue
+				case err := <-notifierDone:
+					if err != nil {
+						destination.ErrorWithContext(lastErrCtx, err)
+					} else {
+						destination.CompleteWithContext(lastErrCtx)
+					}
+
+					return subscriptions.Unsubscribe
+				case <-subscriberCtx.Done():
+					destination.ErrorWithContext(subscriberCtx, subscriberCtx.Err())
+					return subscriptions.Unsubscribe
+				}
+			}
+
+			return subscriptions.Unsubscribe
+		})
+	}
+}
+
 // ThrowIfEmpty throws an error if the source observable is empty. It will
 // throw the error returned by the throw function. If the source observable
 // emits a value, it will complete. If the source observable emits an error,
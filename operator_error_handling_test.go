@@ -16,6 +16,7 @@ package ro
 
 import (
 	"errors"
+	"fmt"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -59,6 +60,66 @@ func TestOperatorErrorHandlingCatch(t *testing.T) {
 	is.NoError(err)
 }
 
+func TestOperatorErrorHandlingCatchWith(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// no error -> handler never invoked
+	values, err := Collect(
+		Pipe1(
+			Of(1, 2, 3),
+			CatchWith(func(err error, retryCount int) Observable[int] {
+				is.Fail("never")
+				return Empty[int]()
+			}),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// retry twice, then fall back
+	failing := Pipe1(
+		Just(1, 2),
+		ConcatWith(Throw[int](assert.AnError)),
+	)
+	attempts := []int{}
+	values, err = Collect(
+		Pipe1(
+			failing,
+			CatchWith(func(err error, retryCount int) Observable[int] {
+				is.EqualError(err, assert.AnError.Error())
+				attempts = append(attempts, retryCount)
+				if retryCount < 3 {
+					return failing
+				}
+				return Just(42)
+			}),
+		),
+	)
+	is.Equal([]int{1, 2, 1, 2, 1, 2, 42}, values)
+	is.Equal([]int{1, 2, 3}, attempts)
+	is.NoError(err)
+
+	// rethrow by returning nil
+	obs := Pipe1(
+		failing,
+		CatchWith(func(err error, retryCount int) Observable[int] {
+			if retryCount < 2 {
+				return failing
+			}
+			return nil
+		}),
+	)
+	values, err = Collect(obs)
+	is.Equal([]int{1, 2, 1, 2}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// counter resets on fresh subscription
+	values, err = Collect(obs)
+	is.Equal([]int{1, 2, 1, 2}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorErrorHandlingOnErrorResumeNextWith(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -188,6 +249,34 @@ func TestOperatorErrorHandlingOnErrorReturn(t *testing.T) {
 	is.NoError(err)
 }
 
+func TestOperatorErrorHandlingOnErrorReturnBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Of("a", "b"),
+			OnErrorReturnBy(func(err error) string {
+				return "fallback: " + err.Error()
+			}),
+		),
+	)
+	is.Equal([]string{"a", "b"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe2(
+			Just("a", "b"),
+			ConcatWith(Throw[string](assert.AnError)),
+			OnErrorReturnBy(func(err error) string {
+				return "fallback: " + err.Error()
+			}),
+		),
+	)
+	is.Equal([]string{"a", "b", "fallback: " + assert.AnError.Error()}, values)
+	is.NoError(err)
+}
+
 func TestOperatorErrorHandlingRetry(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -222,6 +311,66 @@ func TestOperatorErrorHandlingRetry(t *testing.T) {
 	is.NoError(err)
 }
 
+func TestOperatorErrorHandlingRetryUntil(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 400*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Of(1, 2, 3),
+			RetryUntil[int](100*time.Millisecond),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// recovers when the source eventually succeeds within the deadline
+	crash := 0
+	values, err = Collect(
+		Pipe2(
+			Of(1, 2, 3),
+			Map(func(x int) int {
+				if x == 3 && crash < 2 {
+					crash++
+
+					panic(assert.AnError)
+				}
+
+				return x
+			}),
+			RetryUntil[int](100*time.Millisecond),
+		),
+	)
+	is.Equal([]int{1, 2, 1, 2, 1, 2, 3}, values)
+	is.NoError(err)
+
+	// the last error is forwarded once the deadline is exceeded
+	attempts := 0
+	start := time.Now()
+	values, err = Collect(
+		Pipe1(
+			NewObservable(func(destination Observer[int]) Teardown {
+				attempts++
+
+				time.Sleep(30 * time.Millisecond)
+				destination.Error(assert.AnError)
+
+				return nil
+			}),
+			RetryUntil[int](50*time.Millisecond),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.GreaterOrEqual(time.Since(start), 50*time.Millisecond)
+	is.Equal(2, attempts)
+
+	is.PanicsWithError(ErrRetryUntilWrongDeadline.Error(), func() {
+		RetryUntil[int](0)
+	})
+}
+
 func TestOperatorErrorHandlingRetryWithConfig(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 400*time.Millisecond)
@@ -312,6 +461,191 @@ func TestOperatorErrorHandlingRetryWithConfig(t *testing.T) { //nolint:parallelt
 	is.EqualError(err, "ro.Observer: "+assert.AnError.Error())
 }
 
+func TestOperatorErrorHandlingRetryWithConfigBackoff(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	// delays grow with the backoff factor: 20ms + 40ms + 80ms
+	start := time.Now()
+	values, err := Collect(
+		Pipe1(
+			ConcatWith(Throw[int](assert.AnError))(Just(1)),
+			RetryWithConfig[int](RetryConfig{
+				MaxRetries:    3,
+				Delay:         20 * time.Millisecond,
+				BackoffFactor: 2,
+			}),
+		),
+	)
+	is.Equal([]int{1, 1, 1, 1}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.WithinDuration(time.Now(), start.Add(140*time.Millisecond), 50*time.Millisecond)
+
+	// MaxDelay caps the growth: 20ms + 30ms + 30ms
+	start = time.Now()
+	_, err = Collect(
+		Pipe1(
+			ConcatWith(Throw[int](assert.AnError))(Just(1)),
+			RetryWithConfig[int](RetryConfig{
+				MaxRetries:    3,
+				Delay:         20 * time.Millisecond,
+				BackoffFactor: 10,
+				MaxDelay:      30 * time.Millisecond,
+			}),
+		),
+	)
+	is.EqualError(err, assert.AnError.Error())
+	is.WithinDuration(time.Now(), start.Add(80*time.Millisecond), 50*time.Millisecond)
+
+	// non-retryable errors fail immediately
+	attempts := 0
+	_, err = Collect(
+		Pipe1(
+			NewObservable(func(destination Observer[int]) Teardown {
+				attempts++
+				destination.Error(assert.AnError)
+
+				return nil
+			}),
+			RetryWithConfig[int](RetryConfig{
+				MaxRetries:  5,
+				ShouldRetry: func(err error) bool { return false },
+			}),
+		),
+	)
+	is.Equal(1, attempts)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrRetryWithConfigWrongBackoffFactor.Error(), func() {
+		RetryWithConfig[int](RetryConfig{BackoffFactor: 0.5})
+	})
+	is.PanicsWithError(ErrRetryWithConfigWrongJitter.Error(), func() {
+		RetryWithConfig[int](RetryConfig{Jitter: 1.5})
+	})
+}
+
+func TestOperatorErrorHandlingRetryWithConfigAggregateErrors(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	// the final error wraps the error of every attempt
+	attempts := 0
+	source := NewObservable(func(destination Observer[int]) Teardown {
+		attempts++
+		destination.Error(fmt.Errorf("attempt %d: boom", attempts))
+
+		return nil
+	})
+
+	values, err := Collect(
+		Pipe1(
+			source,
+			RetryWithConfig[int](RetryConfig{
+				MaxRetries:      2,
+				AggregateErrors: true,
+			}),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, "attempt 1: boom\nattempt 2: boom\nattempt 3: boom")
+
+	// without the option, only the last error is forwarded
+	attempts = 0
+	_, err = Collect(
+		Pipe1(
+			source,
+			RetryWithConfig[int](RetryConfig{
+				MaxRetries: 2,
+			}),
+		),
+	)
+	is.EqualError(err, "attempt 3: boom")
+}
+
+func TestOperatorErrorHandlingRetryWhen(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	retryAlways := func(errs Observable[error]) Observable[any] {
+		return Pipe1(
+			errs,
+			Map(func(err error) any {
+				return err
+			}),
+		)
+	}
+
+	// no error: values pass through untouched
+	values, err := Collect(
+		Pipe1(
+			Of(1, 2, 3),
+			RetryWhen[int](retryAlways),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// retries until the source stops crashing
+	crash := 0
+	values, err = Collect(
+		Pipe2(
+			Of(1, 2, 3),
+			Map(func(x int) int {
+				if x == 3 && crash < 2 {
+					crash++
+
+					panic(assert.AnError)
+				}
+
+				return x
+			}),
+			RetryWhen[int](retryAlways),
+		),
+	)
+	is.Equal([]int{1, 2, 1, 2, 1, 2, 3}, values)
+	is.NoError(err)
+
+	// notifier completion completes the output without error
+	values, err = Collect(
+		Pipe2(
+			Just(1, 2),
+			ConcatWith(Throw[int](assert.AnError)),
+			RetryWhen[int](func(errs Observable[error]) Observable[any] {
+				return Pipe2(
+					errs,
+					Take[error](2),
+					Map(func(err error) any {
+						return err
+					}),
+				)
+			}),
+		),
+	)
+	is.Equal([]int{1, 2, 1, 2, 1, 2}, values)
+	is.NoError(err)
+
+	// notifier error is forwarded downstream
+	values, err = Collect(
+		Pipe2(
+			Just(1, 2),
+			ConcatWith(Throw[int](assert.AnError)),
+			RetryWhen[int](func(errs Observable[error]) Observable[any] {
+				return Pipe1(
+					errs,
+					MapErr(func(err error) (any, error) {
+						return nil, err
+					}),
+				)
+			}),
+		),
+	)
+	is.Equal([]int{1, 2}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorErrorHandlingThrowIfEmpty(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
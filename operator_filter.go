@@ -15,10 +15,14 @@
 package ro
 
 import (
+	"container/list"
 	"context"
+	"os"
 	"sync/atomic"
+	"time"
 
 	"github.com/samber/lo"
+	"github.com/samber/ro/internal/constraints"
 )
 
 // Filter emits only those items from an Observable that pass a predicate test.
@@ -73,6 +77,73 @@ func FilterIWithContext[T any](predicate func(ctx context.Context, item T, index
 	}
 }
 
+// FilterErr emits only those items from an Observable that pass a predicate
+// test that can fail. When the predicate returns an error, it is converted
+// into an Error notification, mirroring MapErr semantics, and subsequent
+// items are ignored.
+func FilterErr[T any](predicate func(item T) (bool, error)) func(Observable[T]) Observable[T] {
+	return FilterErrIWithContext(func(ctx context.Context, v T, _ int64) (context.Context, bool, error) {
+		ok, err := predicate(v)
+		return ctx, ok, err
+	})
+}
+
+// FilterErrWithContext emits only those items from an Observable that pass a
+// predicate test that can fail. When the predicate returns an error, it is
+// converted into an Error notification, mirroring MapErr semantics, and
+// subsequent items are ignored.
+func FilterErrWithContext[T any](predicate func(ctx context.Context, item T) (context.Context, bool, error)) func(Observable[T]) Observable[T] {
+	return FilterErrIWithContext(func(ctx context.Context, v T, _ int64) (context.Context, bool, error) {
+		return predicate(ctx, v)
+	})
+}
+
+// FilterErrI emits only those items from an Observable that pass a predicate
+// test that can fail. When the predicate returns an error, it is converted
+// into an Error notification, mirroring MapErr semantics, and subsequent
+// items are ignored.
+func FilterErrI[T any](predicate func(item T, index int64) (bool, error)) func(Observable[T]) Observable[T] {
+	return FilterErrIWithContext(func(ctx context.Context, v T, i int64) (context.Context, bool, error) {
+		ok, err := predicate(v, i)
+		return ctx, ok, err
+	})
+}
+
+// FilterErrIWithContext emits only those items from an Observable that pass a
+// predicate test that can fail. When the predicate returns an error, it is
+// converted into an Error notification, mirroring MapErr semantics, and
+// subsequent items are ignored.
+func FilterErrIWithContext[T any](predicate func(ctx context.Context, item T, index int64) (context.Context, bool, error)) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			i := int64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						ctx, ok, err := predicate(ctx, value, i)
+						i++
+
+						if err != nil {
+							destination.ErrorWithContext(ctx, err)
+							return
+						}
+
+						if ok {
+							destination.NextWithContext(ctx, value)
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // Distinct suppresses duplicate items in an Observable.
 // Play: https://go.dev/play/p/szxp8gO0_I7
 func Distinct[T comparable]() func(Observable[T]) Observable[T] {
@@ -135,6 +206,243 @@ func DistinctByWithContext[T any, K comparable](keySelector func(ctx context.Con
 	}
 }
 
+// DistinctByWithTTL suppresses duplicate items in an Observable based on a key
+// selector, with bounded memory and time: a key expires ttl after its last
+// sighting, and when more than maxKeys keys are tracked, the least recently
+// seen one is evicted. An expired or evicted key is treated as new again, so
+// this is the right dedup for long-running streams over unbounded key spaces.
+// It panics if ttl or maxKeys is lower than or equal to 0.
+func DistinctByWithTTL[T any, K comparable](keySelector func(item T) K, ttl time.Duration, maxKeys int) func(Observable[T]) Observable[T] {
+	if ttl <= 0 {
+		panic(ErrDistinctByWithTTLWrongTTL)
+	}
+
+	if maxKeys <= 0 {
+		panic(ErrDistinctByWithTTLWrongMaxKeys)
+	}
+
+	type entry struct {
+		key  K
+		seen time.Time
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			order := list.New()
+			seen := map[K]*list.Element{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						now := time.Now()
+
+						// every sighting refreshes the timestamp, so recency
+						// order matches timestamp order and expired entries
+						// cluster at the back of the list
+						for e := order.Back(); e != nil; e = order.Back() {
+							if now.Sub(e.Value.(*entry).seen) < ttl { //nolint:errcheck,forcetypeassert
+								break
+							}
+
+							delete(seen, e.Value.(*entry).key) //nolint:errcheck,forcetypeassert
+							order.Remove(e)
+						}
+
+						key := keySelector(value)
+
+						if e, ok := seen[key]; ok {
+							e.Value.(*entry).seen = now //nolint:errcheck,forcetypeassert
+							order.MoveToFront(e)
+
+							return
+						}
+
+						seen[key] = order.PushFront(&entry{key: key, seen: now})
+
+						if order.Len() > maxKeys {
+							oldest := order.Back()
+							delete(seen, oldest.Value.(*entry).key) //nolint:errcheck,forcetypeassert
+							order.Remove(oldest)
+						}
+
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				order = nil
+				seen = nil
+			}
+		})
+	}
+}
+
+// DistinctUntilChanged suppresses consecutive duplicate items in an Observable.
+// Unlike Distinct, it only compares each item to the previous one, so it
+// requires O(1) memory and is safe on infinite streams.
+func DistinctUntilChanged[T comparable]() func(Observable[T]) Observable[T] {
+	return DistinctUntilChangedBy(func(prev, cur T) bool {
+		return prev == cur
+	})
+}
+
+// DistinctUntilChangedBy suppresses consecutive duplicate items in an
+// Observable, using a custom equality function to compare each item to the
+// previous one.
+func DistinctUntilChangedBy[T any](eq func(prev, cur T) bool) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var prev T
+
+			first := true
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if first || !eq(prev, value) {
+							destination.NextWithContext(ctx, value)
+						}
+
+						prev = value
+						first = false
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// DistinctUntilKeyChanged suppresses consecutive duplicate items in an
+// Observable based on a key selector. It only compares each key to the
+// previous one, so it requires O(1) memory and is safe on infinite streams.
+func DistinctUntilKeyChanged[T any, K comparable](keySelector func(item T) K) func(Observable[T]) Observable[T] {
+	return DistinctUntilChangedBy(func(prev, cur T) bool {
+		return keySelector(prev) == keySelector(cur)
+	})
+}
+
+// DedupSequence suppresses values that repeat a sequence of recent values.
+// A value is suppressed when the last `windowSize` values (including the
+// current one) exactly match the `windowSize` values emitted just before
+// them. This catches repeated patterns (e.g. flapping alerts) that the
+// single-value Distinct misses. Memory is bounded to twice the window size.
+func DedupSequence[T comparable](windowSize int) func(Observable[T]) Observable[T] {
+	if windowSize < 1 {
+		panic(ErrDedupSequenceWrongWindowSize)
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			recent := make([]T, 0, 2*windowSize)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						recent = append(recent, value)
+						if len(recent) > 2*windowSize {
+							recent = recent[1:]
+						}
+
+						if len(recent) == 2*windowSize {
+							repeated := true
+
+							for i := 0; i < windowSize; i++ {
+								if recent[i] != recent[windowSize+i] {
+									repeated = false
+
+									break
+								}
+							}
+
+							if repeated {
+								return
+							}
+						}
+
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				recent = []T{}
+			}
+		})
+	}
+}
+
+// MonotonicPolicy is the policy applied by EnsureMonotonic when a value
+// violates the monotonic ordering of the stream.
+type MonotonicPolicy int8
+
+const (
+	// MonotonicPolicyDrop silently drops values whose key is lower than the
+	// highest key seen so far.
+	MonotonicPolicyDrop MonotonicPolicy = iota
+	// MonotonicPolicyError terminates the stream with ErrEnsureMonotonicOutOfOrder
+	// when a value arrives out of order.
+	MonotonicPolicyError
+)
+
+// EnsureMonotonic enforces a non-decreasing ordering on the keys extracted
+// from each value (e.g. timestamps or sequence numbers). It tracks the highest
+// key seen so far and, when a value arrives with a lower key, either drops it
+// or errors, depending on the policy. Equal keys are considered in order.
+func EnsureMonotonic[T any, K constraints.Ordered](keySelector func(item T) K, policy MonotonicPolicy) func(Observable[T]) Observable[T] {
+	if policy != MonotonicPolicyDrop && policy != MonotonicPolicyError {
+		panic(ErrEnsureMonotonicWrongPolicy)
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var last K
+
+			first := true
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						key := keySelector(value)
+						if !first && key < last {
+							if policy == MonotonicPolicyError {
+								destination.ErrorWithContext(ctx, ErrEnsureMonotonicOutOfOrder)
+							}
+
+							return
+						}
+
+						last = key
+						first = false
+
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // IgnoreElements does not emit any items from an Observable but mirrors its
 // termination notification. It is useful for ignoring all the items from an
 // Observable but you want to be notified when it completes or when it throws an error.
@@ -548,6 +856,57 @@ func TakeUntil[T, S any](signal Observable[S]) func(Observable[T]) Observable[T]
 	}
 }
 
+// TakeUntilContext mirrors TakeUntil, with context cancellation as the
+// signal: values are forwarded until ctx.Done() fires, then the stream
+// completes. Values racing with the cancellation are dropped after the
+// terminal notification. This is useful for wiring pipelines into HTTP
+// handlers and graceful shutdown.
+func TakeUntilContext[T any](ctx context.Context) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			ready := uint32(0)
+			stop := make(chan struct{})
+
+			go func() {
+				select {
+				case <-ctx.Done():
+					atomic.StoreUint32(&ready, 1)
+					destination.CompleteWithContext(subscriberCtx)
+				case <-stop:
+				}
+			}()
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if atomic.LoadUint32(&ready) == 1 {
+							return
+						}
+
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return func() {
+				close(stop)
+				sub.Unsubscribe()
+			}
+		})
+	}
+}
+
+// TakeUntilSignal forwards values until the process receives one of the
+// listed operating system signals (e.g. SIGINT, SIGTERM), then completes
+// the stream. It is sugar over TakeUntil and FromSignal for graceful
+// shutdown of long-running pipelines.
+func TakeUntilSignal[T any](signals ...os.Signal) func(Observable[T]) Observable[T] {
+	return TakeUntil[T](FromSignal(signals...))
+}
+
 // Head emits only the first item emitted by an Observable. If the source Observable
 // is empty, Head will emit an error.
 // Play: https://go.dev/play/p/TmhTvpuKAp_U
@@ -606,6 +965,87 @@ func Tail[T any]() func(Observable[T]) Observable[T] {
 	}
 }
 
+// Single verifies that the source Observable emits exactly one item: the item
+// is forwarded on completion, an empty source errors with ErrEmpty, and a
+// second emission errors with ErrTooManyElements immediately, without waiting
+// for the source to complete. Both sentinels are usable with errors.Is. It is
+// handy for queries that must match exactly one row.
+func Single[T any]() func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var first lo.Tuple2[context.Context, T]
+
+			hasValue := false
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if hasValue {
+							destination.ErrorWithContext(ctx, ErrTooManyElements)
+							return
+						}
+
+						first = lo.T2(ctx, value)
+						hasValue = true
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if hasValue {
+							destination.NextWithContext(first.A, first.B)
+							destination.CompleteWithContext(ctx)
+						} else {
+							destination.ErrorWithContext(ctx, ErrEmpty)
+						}
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// SingleOrDefault verifies that the source Observable emits at most one item.
+// An empty source emits the fallback value and completes without error; a
+// second emission errors with ErrTooManyElements immediately.
+func SingleOrDefault[T any](fallback T) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var first lo.Tuple2[context.Context, T]
+
+			hasValue := false
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if hasValue {
+							destination.ErrorWithContext(ctx, ErrTooManyElements)
+							return
+						}
+
+						first = lo.T2(ctx, value)
+						hasValue = true
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if hasValue {
+							destination.NextWithContext(first.A, first.B)
+						} else {
+							destination.NextWithContext(ctx, fallback)
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // First emits only the first item emitted by an Observable that satisfies a specified
 // condition. If the source Observable is empty, First will emit an error.
 // Play: https://go.dev/play/p/yneVKit6vh0
@@ -661,6 +1101,32 @@ func FirstIWithContext[T any](predicate func(ctx context.Context, item T, index
 	}
 }
 
+// FirstOrDefault emits only the first item emitted by an Observable, without
+// requiring a predicate. If the source Observable is empty, FirstOrDefault
+// will emit a fallback value and complete without error.
+func FirstOrDefault[T any](fallback T) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						destination.NextWithContext(ctx, value)
+						destination.CompleteWithContext(ctx)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, fallback)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // Last emits only the last item emitted by an Observable that satisfies a specified
 // condition. If the source Observable is empty, Last will emit an error.
 // Play: https://go.dev/play/p/aMsvsTPbmHY
@@ -724,6 +1190,37 @@ func LastIWithContext[T any](predicate func(ctx context.Context, item T, index i
 	}
 }
 
+// LastOrDefault emits only the last item emitted by an Observable, without
+// requiring a predicate. If the source Observable is empty, LastOrDefault
+// will emit a fallback value and complete without error.
+func LastOrDefault[T any](fallback T) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			last := lo.T2(context.Context(nil), fallback)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						last = lo.T2(ctx, value)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if last.A != nil {
+							ctx = last.A
+						}
+
+						destination.NextWithContext(ctx, last.B)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // ElementAt emits only the nth item emitted by an Observable. If the source Observable
 // emits fewer than n items, ElementAt will emit an error.
 // Play: https://go.dev/play/p/0YE1tCbPaDg
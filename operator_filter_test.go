@@ -15,9 +15,12 @@
 package ro
 
 import (
+	"context"
+	"syscall"
 	"testing"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -186,6 +189,370 @@ func TestOperatorFilterDistinctBy(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorFilterDistinctByWithTTL(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	// dedup within ttl
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 1, 3, 2, 1),
+			DistinctByWithTTL(func(item int) int { return item }, 1*time.Hour, 100),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// key selector
+	type user struct {
+		id   int
+		name string
+	}
+	users, err := Collect(
+		Pipe1(
+			Just(
+				user{id: 1, name: "John"},
+				user{id: 2, name: "Jane"},
+				user{id: 1, name: "Johnny"},
+			),
+			DistinctByWithTTL(func(item user) int { return item.id }, 1*time.Hour, 100),
+		),
+	)
+	is.Equal([]user{{id: 1, name: "John"}, {id: 2, name: "Jane"}}, users)
+	is.NoError(err)
+
+	// lru eviction: maxKeys=2, so emitting 3 evicts 1, which is new again
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3, 1),
+			DistinctByWithTTL(func(item int) int { return item }, 1*time.Hour, 2),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 1}, values)
+	is.NoError(err)
+
+	// a sighting refreshes recency: 1 is seen again before 3 arrives, so 2 is
+	// the least recently seen key and gets evicted instead
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 1, 3, 2),
+			DistinctByWithTTL(func(item int) int { return item }, 1*time.Hour, 2),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 2}, values)
+	is.NoError(err)
+
+	// ttl expiry: a key seen longer than ttl ago is new again
+	values, err = Collect(
+		Pipe1(
+			NewObservable(func(destination Observer[int]) Teardown {
+				destination.Next(1)
+				destination.Next(1)
+				time.Sleep(50 * time.Millisecond)
+				destination.Next(1)
+				destination.Complete()
+				return nil
+			}),
+			DistinctByWithTTL(func(item int) int { return item }, 20*time.Millisecond, 100),
+		),
+	)
+	is.Equal([]int{1, 1}, values)
+	is.NoError(err)
+
+	// empty
+	values, err = Collect(
+		Pipe1(
+			Empty[int](),
+			DistinctByWithTTL(func(item int) int { return item }, 1*time.Hour, 100),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	// error
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			DistinctByWithTTL(func(item int) int { return item }, 1*time.Hour, 100),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// panics
+	is.PanicsWithError(ErrDistinctByWithTTLWrongTTL.Error(), func() {
+		DistinctByWithTTL(func(item int) int { return item }, 0, 100)
+	})
+	is.PanicsWithError(ErrDistinctByWithTTLWrongMaxKeys.Error(), func() {
+		DistinctByWithTTL(func(item int) int { return item }, 1*time.Hour, 0)
+	})
+}
+
+// BenchmarkDistinctByWithTTL measures eviction overhead: every item carries a
+// fresh key, so each one inserts into the LRU and evicts the oldest entry.
+func BenchmarkDistinctByWithTTL(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Collect(
+			Pipe1(
+				Range(0, 10_000),
+				DistinctByWithTTL(func(item int64) int64 { return item }, 1*time.Hour, 128),
+			),
+		)
+	}
+}
+
+func TestOperatorFilterDistinctUntilChanged(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		DistinctUntilChanged[int]()(Just(1, 1, 2, 2, 1, 3, 3)),
+	)
+	is.Equal([]int{1, 2, 1, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		DistinctUntilChanged[int]()(Empty[int]()),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		DistinctUntilChanged[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorFilterDistinctUntilChangedBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		DistinctUntilChangedBy(func(prev, cur int) bool {
+			return prev/10 == cur/10
+		})(Just(11, 12, 25, 26, 13)),
+	)
+	is.Equal([]int{11, 25, 13}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		DistinctUntilChangedBy(func(prev, cur int) bool {
+			return prev == cur
+		})(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorFilterDistinctUntilKeyChanged(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type user struct {
+		id   int
+		name string
+	}
+
+	values, err := Collect(
+		DistinctUntilKeyChanged(func(item user) int {
+			return item.id
+		})(Just(
+			user{id: 1, name: "samuel"},
+			user{id: 1, name: "sam"},
+			user{id: 2, name: "john"},
+			user{id: 1, name: "samuel"},
+		)),
+	)
+	is.Equal([]user{
+		{id: 1, name: "samuel"},
+		{id: 2, name: "john"},
+		{id: 1, name: "samuel"},
+	}, values)
+	is.NoError(err)
+}
+
+func TestOperatorFilterDedupSequence(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// flapping "a b" pattern is suppressed after the first occurrence
+	values, err := Collect(
+		DedupSequence[string](2)(Just("a", "b", "a", "b", "a", "b")),
+	)
+	is.Equal([]string{"a", "b", "a"}, values)
+	is.NoError(err)
+
+	// non-repeating stream is passed through
+	values, err = Collect(
+		DedupSequence[string](2)(Just("a", "b", "c", "d")),
+	)
+	is.Equal([]string{"a", "b", "c", "d"}, values)
+	is.NoError(err)
+
+	// windowSize 1 suppresses consecutive duplicates
+	intValues, err := Collect(
+		DedupSequence[int](1)(Just(1, 1, 2, 2, 3)),
+	)
+	is.Equal([]int{1, 2, 3}, intValues)
+	is.NoError(err)
+
+	intValues, err = Collect(
+		DedupSequence[int](2)(Empty[int]()),
+	)
+	is.Equal([]int{}, intValues)
+	is.NoError(err)
+
+	is.PanicsWithError("ro.DedupSequence: windowSize must be greater than 0", func() {
+		DedupSequence[int](0)(Just(1, 2, 3))
+	})
+
+	intValues, err = Collect(
+		DedupSequence[int](2)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, intValues)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorFilterFilterErr(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3, 4, 5),
+			FilterErr(func(i int) (bool, error) {
+				return i%2 == 0, nil
+			}),
+		),
+	)
+	is.Equal([]int{2, 4}, values)
+	is.NoError(err)
+
+	// a predicate error is converted into an Error notification
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3, 4, 5),
+			FilterErr(func(i int) (bool, error) {
+				if i == 3 {
+					return false, assert.AnError
+				}
+
+				return i%2 == 0, nil
+			}),
+		),
+	)
+	is.Equal([]int{2}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			FilterErr(func(i int) (bool, error) {
+				return true, nil
+			}),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorFilterFilterErrI(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Just(42, 42, 42),
+			FilterErrI(func(i int, index int64) (bool, error) {
+				return index%2 == 0, nil
+			}),
+		),
+	)
+	is.Equal([]int{42, 42}, values)
+	is.NoError(err)
+}
+
+func TestOperatorFilterEnsureMonotonic(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	identity := func(item int) int { return item }
+
+	// in-order stream is passed through, equal keys are allowed
+	values, err := Collect(
+		EnsureMonotonic(identity, MonotonicPolicyDrop)(Just(1, 2, 2, 3)),
+	)
+	is.Equal([]int{1, 2, 2, 3}, values)
+	is.NoError(err)
+
+	// out-of-order values are dropped
+	values, err = Collect(
+		EnsureMonotonic(identity, MonotonicPolicyDrop)(Just(1, 3, 2, 4, 1, 5)),
+	)
+	is.Equal([]int{1, 3, 4, 5}, values)
+	is.NoError(err)
+
+	// out-of-order values terminate the stream
+	values, err = Collect(
+		EnsureMonotonic(identity, MonotonicPolicyError)(Just(1, 3, 2, 4)),
+	)
+	is.Equal([]int{1, 3}, values)
+	is.EqualError(err, ErrEnsureMonotonicOutOfOrder.Error())
+
+	// key selector
+	type event struct {
+		seq  int64
+		name string
+	}
+	events, err := Collect(
+		EnsureMonotonic(func(item event) int64 { return item.seq }, MonotonicPolicyDrop)(
+			Just(event{1, "a"}, event{3, "b"}, event{2, "c"}),
+		),
+	)
+	is.Equal([]event{{1, "a"}, {3, "b"}}, events)
+	is.NoError(err)
+
+	values, err = Collect(
+		EnsureMonotonic(identity, MonotonicPolicyDrop)(Empty[int]()),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		EnsureMonotonic(identity, MonotonicPolicyDrop)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError("ro.EnsureMonotonic: unexpected monotonic policy", func() {
+		EnsureMonotonic(identity, MonotonicPolicy(42))(Just(1, 2, 3))
+	})
+}
+
+func TestOperatorFilterTakeUntilSignal(t *testing.T) { //nolint:paralleltest
+	// t.Parallel() // signal delivery is process-wide
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	go func() {
+		time.Sleep(70 * time.Millisecond)
+
+		_ = syscall.Kill(syscall.Getpid(), syscall.SIGUSR2)
+	}()
+
+	values, err := Collect(
+		Pipe1(
+			RangeWithInterval(0, 10, 20*time.Millisecond),
+			TakeUntilSignal[int64](syscall.SIGUSR2),
+		),
+	)
+	is.NoError(err)
+	is.Greater(len(values), 0)
+	is.Less(len(values), 10)
+}
+
 func TestOperatorFilterIgnoreElements(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -646,6 +1013,44 @@ func TestOperatorFilterTakeUntil(t *testing.T) { //nolint:paralleltest
 	is.NoError(err)
 }
 
+func TestOperatorFilterTakeUntilContext(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	// cancellation completes the stream
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	values, err := Collect(
+		Pipe1(
+			RangeWithInterval(0, 5, 20*time.Millisecond),
+			TakeUntilContext[int64](ctx),
+		),
+	)
+	is.Equal([]int64{0, 1}, values)
+	is.NoError(err)
+
+	// a context that is never cancelled does not interfere
+	values, err = Collect(
+		Pipe1(
+			Just[int64](1, 2, 3),
+			TakeUntilContext[int64](context.Background()),
+		),
+	)
+	is.Equal([]int64{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int64](assert.AnError),
+			TakeUntilContext[int64](context.Background()),
+		),
+	)
+	is.Equal([]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorFilterHead(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -704,6 +1109,98 @@ func TestOperatorFilterTail(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorFilterSingle(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Single[int]()(Just(1)),
+	)
+	is.Equal([]int{1}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Single[int]()(Just(1, 2, 3)),
+	)
+	is.Equal([]int{}, values)
+	is.ErrorIs(err, ErrTooManyElements)
+
+	values, err = Collect(
+		Single[int]()(Empty[int]()),
+	)
+	is.Equal([]int{}, values)
+	is.ErrorIs(err, ErrEmpty)
+
+	values, err = Collect(
+		Single[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// the second item fails fast: the error must be observed long before the
+	// source completes
+	mu := lo.Synchronize()
+	start := time.Now()
+
+	var elapsed time.Duration
+	var thrown error
+
+	sub := Single[int]()(NewObservable(func(destination Observer[int]) Teardown {
+		destination.Next(1)
+		destination.Next(2)
+		time.Sleep(200 * time.Millisecond)
+		destination.Complete()
+		return nil
+	})).Subscribe(
+		NewObserver(
+			func(value int) {},
+			func(err error) {
+				mu.Do(func() {
+					elapsed = time.Since(start)
+					thrown = err
+				})
+			},
+			func() {},
+		),
+	)
+	sub.Wait()
+
+	mu.Do(func() {
+		is.ErrorIs(thrown, ErrTooManyElements)
+		is.Less(elapsed, 150*time.Millisecond)
+	})
+}
+
+func TestOperatorFilterSingleOrDefault(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		SingleOrDefault(42)(Just(1)),
+	)
+	is.Equal([]int{1}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		SingleOrDefault(42)(Empty[int]()),
+	)
+	is.Equal([]int{42}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		SingleOrDefault(42)(Just(1, 2)),
+	)
+	is.Equal([]int{}, values)
+	is.ErrorIs(err, ErrTooManyElements)
+
+	values, err = Collect(
+		SingleOrDefault(42)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorFilterFirst(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -764,6 +1261,38 @@ func TestOperatorFilterFirst(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorFilterFirstOrDefault(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			FirstOrDefault(42),
+		),
+	)
+	is.Equal([]int{1}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Empty[int](),
+			FirstOrDefault(42),
+		),
+	)
+	is.Equal([]int{42}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			FirstOrDefault(42),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorFilterLast(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -860,6 +1389,38 @@ func TestOperatorFilterElementAt(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorFilterLastOrDefault(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			LastOrDefault(42),
+		),
+	)
+	is.Equal([]int{3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Empty[int](),
+			LastOrDefault(42),
+		),
+	)
+	is.Equal([]int{42}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			LastOrDefault(42),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorFilterElementAtOrDefault(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -17,6 +17,8 @@ package ro
 import (
 	"context"
 	"math"
+	"sort"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/samber/ro/internal/constraints"
@@ -57,6 +59,41 @@ func Average[T constraints.Numeric]() func(Observable[T]) Observable[float64] {
 	}
 }
 
+// AverageBy calculates the average of the numeric values extracted from each
+// item by the selector. It emits the average when the source completes. If the
+// source is empty, it emits NaN.
+func AverageBy[T any, N constraints.Numeric](selector func(item T) N) func(Observable[T]) Observable[float64] {
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			sum := float64(0)
+			count := int64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						sum += float64(selector(value))
+						count++
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if count == 0 {
+							destination.NextWithContext(ctx, math.NaN())
+							destination.CompleteWithContext(ctx)
+						}
+
+						avg := sum / float64(count)
+						destination.NextWithContext(ctx, avg)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // Count counts the number of values emitted by the source Observable.
 // It emits the count when the source completes.
 // Play: https://go.dev/play/p/igtOxOLeHPp
@@ -84,6 +121,143 @@ func Count[T any]() func(Observable[T]) Observable[int64] {
 	}
 }
 
+// CountWhere counts the number of values emitted by the source Observable that
+// match the predicate. It emits the count when the source completes.
+// If the source emits more than math.MaxInt64 matching values, the count
+// saturates at math.MaxInt64.
+func CountWhere[T any](predicate func(item T) bool) func(Observable[T]) Observable[int64] {
+	return func(source Observable[T]) Observable[int64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[int64]) Teardown {
+			count := int64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if predicate(value) && count < math.MaxInt64 {
+							count++
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, count)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// CountBy is an alias to CountWhere.
+func CountBy[T any](predicate func(item T) bool) func(Observable[T]) Observable[int64] {
+	return CountWhere(predicate)
+}
+
+// CountByKey counts the values emitted by the source Observable per key. It
+// emits the counts as a map when the source completes. On error, the error is
+// propagated without emitting a partial map. If the source is empty, it emits
+// an empty map.
+func CountByKey[T any, K comparable](key func(item T) K) func(Observable[T]) Observable[map[K]int64] {
+	return func(source Observable[T]) Observable[map[K]int64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[map[K]int64]) Teardown {
+			counts := map[K]int64{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						counts[key(value)]++
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, counts)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// CountDistinct counts the number of distinct values emitted by the source
+// Observable. It emits the count when the source completes. The set of values
+// already seen is kept in memory, so an unbounded source leads to unbounded
+// memory usage. If the source emits more than math.MaxInt64 distinct values,
+// the count saturates at math.MaxInt64.
+func CountDistinct[T comparable]() func(Observable[T]) Observable[int64] {
+	return func(source Observable[T]) Observable[int64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[int64]) Teardown {
+			seen := map[T]struct{}{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						seen[value] = struct{}{}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, int64(len(seen)))
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				seen = map[T]struct{}{}
+			}
+		})
+	}
+}
+
+// RunningCount counts the number of values emitted by the source Observable.
+// It emits the updated count after each value, instead of waiting for the
+// source to complete like Count. If the source emits more than math.MaxInt64
+// values, the count saturates at math.MaxInt64.
+func RunningCount[T any]() func(Observable[T]) Observable[int64] {
+	return RunningCountWhere[T](func(item T) bool {
+		return true
+	})
+}
+
+// RunningCountWhere counts the number of values emitted by the source
+// Observable that match the predicate. It emits the updated count after each
+// matching value. If the source emits more than math.MaxInt64 matching values,
+// the count saturates at math.MaxInt64.
+func RunningCountWhere[T any](predicate func(item T) bool) func(Observable[T]) Observable[int64] {
+	return func(source Observable[T]) Observable[int64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[int64]) Teardown {
+			count := int64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if predicate(value) {
+							if count < math.MaxInt64 {
+								count++
+							}
+
+							destination.NextWithContext(ctx, count)
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // Sum calculates the sum of the values emitted by the source Observable.
 // It emits the sum when the source completes.
 // Play: https://go.dev/play/p/b3rRlI80igo
@@ -111,6 +285,250 @@ func Sum[T constraints.Numeric]() func(Observable[T]) Observable[T] {
 	}
 }
 
+// SumBy calculates the sum of the numeric values extracted from each item by
+// the selector. It emits the sum when the source completes.
+func SumBy[T any, N constraints.Numeric](selector func(item T) N) func(Observable[T]) Observable[N] {
+	return func(source Observable[T]) Observable[N] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[N]) Teardown {
+			var sum N
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						sum += selector(value)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, sum)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// Delta emits the difference between each value and its predecessor. The first
+// value produces no emission, since it has no predecessor. Use it to convert a
+// cumulative counter into a per-interval rate.
+func Delta[T constraints.Numeric]() func(Observable[T]) Observable[T] {
+	return DeltaBy(func(item T) T {
+		return item
+	})
+}
+
+// DeltaBy is like Delta, but computes the difference on the numeric value
+// extracted from each item by the selector. The first item produces no
+// emission, since it has no predecessor.
+func DeltaBy[T any, N constraints.Numeric](selector func(item T) N) func(Observable[T]) Observable[N] {
+	return func(source Observable[T]) Observable[N] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[N]) Teardown {
+			var previous N
+
+			first := true
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						current := selector(value)
+
+						if first {
+							first = false
+						} else {
+							destination.NextWithContext(ctx, current-previous)
+						}
+
+						previous = current
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// percentileMaxSamples caps the number of samples kept by Percentile, so that
+// a hot source cannot grow the trailing window without bound.
+const percentileMaxSamples = 1024
+
+// Percentile emits the p-th percentile (0 <= p <= 100) of the values observed
+// over the trailing time window, after each value emitted by the source
+// Observable. Samples older than the window are evicted, and at most
+// percentileMaxSamples recent samples are kept, so memory stays bounded even
+// for hot sources. The percentile is computed with linear interpolation
+// between the two nearest ranks.
+func Percentile[T constraints.Numeric](p float64, window time.Duration) func(Observable[T]) Observable[float64] {
+	if p < 0 || p > 100 {
+		panic(ErrPercentileWrongPercentile)
+	}
+
+	if window <= 0 {
+		panic(ErrPercentileWrongWindow)
+	}
+
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			samples := []lo.Tuple2[time.Time, float64]{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						now := time.Now()
+						deadline := now.Add(-window)
+
+						i := 0
+						for i < len(samples) && samples[i].A.Before(deadline) {
+							i++
+						}
+
+						samples = samples[i:]
+						if len(samples) == percentileMaxSamples {
+							samples = samples[1:]
+						}
+
+						samples = append(samples, lo.T2(now, float64(value)))
+
+						destination.NextWithContext(ctx, percentileOf(samples, p))
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				samples = nil
+			}
+		})
+	}
+}
+
+func percentileOf(samples []lo.Tuple2[time.Time, float64], p float64) float64 {
+	sorted := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		sorted = append(sorted, sample.B)
+	}
+
+	sort.Float64s(sorted)
+
+	return percentileOfSorted(sorted, p)
+}
+
+func percentileOfSorted(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	if lower == upper {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (rank-float64(lower))*(sorted[upper]-sorted[lower])
+}
+
+// PercentileAll emits the p-th percentile (0 < p <= 100) of all the values
+// emitted by the source Observable, when it completes. Unlike Percentile,
+// which maintains a trailing time window, it aggregates the whole stream, so
+// it buffers every value: memory grows linearly with the stream length. The
+// percentile is computed exactly, with linear interpolation between the two
+// nearest ranks. If the source is empty, it emits NaN.
+func PercentileAll[T constraints.Numeric](p float64) func(Observable[T]) Observable[float64] {
+	if p <= 0 || p > 100 {
+		panic(ErrPercentileAllWrongPercentile)
+	}
+
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			values := []float64{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						values = append(values, float64(value))
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if len(values) == 0 {
+							destination.NextWithContext(ctx, math.NaN())
+							destination.CompleteWithContext(ctx)
+
+							return
+						}
+
+						sort.Float64s(values)
+
+						destination.NextWithContext(ctx, percentileOfSorted(values, p))
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				values = nil
+			}
+		})
+	}
+}
+
+// Median emits the median of all the values emitted by the source Observable,
+// when it completes. It buffers every value, like PercentileAll. If the
+// source is empty, it emits NaN.
+func Median[T constraints.Numeric]() func(Observable[T]) Observable[float64] {
+	return PercentileAll[T](50)
+}
+
+// StdDev emits the population standard deviation of all the values emitted by
+// the source Observable, when it completes. It runs Welford's online
+// algorithm, so memory stays constant regardless of the stream length. If the
+// source is empty, it emits NaN.
+func StdDev[T constraints.Numeric]() func(Observable[T]) Observable[float64] {
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			count := float64(0)
+			mean := float64(0)
+			m2 := float64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						count++
+						delta := float64(value) - mean
+						mean += delta / count
+						m2 += delta * (float64(value) - mean)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if count == 0 {
+							destination.NextWithContext(ctx, math.NaN())
+							destination.CompleteWithContext(ctx)
+
+							return
+						}
+
+						destination.NextWithContext(ctx, math.Sqrt(m2/count))
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // Round emits the rounded values emitted by the source Observable.
 // Play: https://go.dev/play/p/aXwxpsJq_BQ
 func Round() func(Observable[float64]) Observable[float64] {
@@ -200,6 +618,133 @@ func Max[T constraints.Numeric]() func(Observable[T]) Observable[T] {
 	}
 }
 
+// MinBy emits the minimum value emitted by the source Observable, per the
+// provided comparator. The comparator reports whether a is less than b. It
+// emits the minimum value when the source completes. If the source is empty,
+// it emits no value.
+func MinBy[T any](less func(a, b T) bool) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var mIn lo.Tuple2[context.Context, T]
+
+			first := true
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if first || less(value, mIn.B) {
+							mIn = lo.T2(ctx, value)
+							first = false
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if !first {
+							destination.NextWithContext(mIn.A, mIn.B)
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// MaxBy emits the maximum value emitted by the source Observable, per the
+// provided comparator. The comparator reports whether a is less than b. It
+// emits the maximum value when the source completes. If the source is empty,
+// it emits no value.
+func MaxBy[T any](less func(a, b T) bool) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var mAx lo.Tuple2[context.Context, T]
+
+			first := true
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if first || less(mAx.B, value) {
+							mAx = lo.T2(ctx, value)
+							first = false
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if !first {
+							destination.NextWithContext(mAx.A, mAx.B)
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// MinByKey emits the item whose numeric value extracted by the selector is the
+// smallest, preserving the original element. It emits the item when the source
+// completes. If the source is empty, it emits no value.
+func MinByKey[T any, N constraints.Numeric](selector func(item T) N) func(Observable[T]) Observable[T] {
+	return MinBy(func(a, b T) bool {
+		return selector(a) < selector(b)
+	})
+}
+
+// MaxByKey emits the item whose numeric value extracted by the selector is the
+// largest, preserving the original element. It emits the item when the source
+// completes. If the source is empty, it emits no value.
+func MaxByKey[T any, N constraints.Numeric](selector func(item T) N) func(Observable[T]) Observable[T] {
+	return MaxBy(func(a, b T) bool {
+		return selector(a) < selector(b)
+	})
+}
+
+// RunningMinMax emits, for each item, a tuple of the current value and the
+// running minimum and maximum observed so far: (current, min, max). The first
+// value seeds both the minimum and the maximum. It is handy for live charts
+// that display the current value against its historical range.
+func RunningMinMax[T constraints.Ordered]() func(Observable[T]) Observable[lo.Tuple3[T, T, T]] {
+	return func(source Observable[T]) Observable[lo.Tuple3[T, T, T]] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[lo.Tuple3[T, T, T]]) Teardown {
+			var minimum, maximum T
+
+			first := true
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if first {
+							minimum = value
+							maximum = value
+							first = false
+						} else if value < minimum {
+							minimum = value
+						} else if value > maximum {
+							maximum = value
+						}
+
+						destination.NextWithContext(ctx, lo.T3(value, minimum, maximum))
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // Clamp emits the number within the inclusive lower and upper bounds.
 // Play: https://go.dev/play/p/fu8O-BixXPM
 func Clamp[T constraints.Numeric](lower, upper T) func(Observable[T]) Observable[T] {
@@ -344,6 +889,13 @@ func ReduceI[T, R any](accumulator func(agg R, item T, index int64) R, seed R) f
 	}, seed)
 }
 
+// ReduceWithIndex is an alias to ReduceI, with an int index.
+func ReduceWithIndex[T, R any](accumulator func(agg R, item T, index int) R, seed R) func(Observable[T]) Observable[R] {
+	return ReduceI(func(agg R, item T, index int64) R {
+		return accumulator(agg, item, int(index))
+	}, seed)
+}
+
 // ReduceIWithContext applies an accumulator function over the source Observable,
 // and emits the result when the source completes. It takes a seed value as the
 // initial accumulator value.
@@ -381,3 +933,50 @@ func ReduceIWithContext[T, R any](accumulator func(ctx context.Context, agg R, i
 		})
 	}
 }
+
+// ReduceErr applies a fallible accumulator function over the source Observable,
+// and emits the result when the source completes. It takes a seed value as the
+// initial accumulator value. When the accumulator returns an error, the stream
+// terminates with an error notification, mirroring MapErr semantics, and
+// subsequent items are ignored.
+func ReduceErr[T, R any](accumulator func(agg R, item T) (R, error), seed R) func(Observable[T]) Observable[R] {
+	return func(source Observable[T]) Observable[R] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[R]) Teardown {
+			output := seed
+			failed := false
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if failed {
+							return
+						}
+
+						agg, err := accumulator(output, value)
+						if err != nil {
+							failed = true
+
+							destination.ErrorWithContext(ctx, err)
+
+							return
+						}
+
+						output = agg
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if failed {
+							return
+						}
+
+						destination.NextWithContext(ctx, output)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
@@ -17,7 +17,9 @@ package ro
 import (
 	"math"
 	"testing"
+	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -56,6 +58,36 @@ func TestOperatorMathAverage(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorMathAverageBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type stock struct {
+		name  string
+		price int
+	}
+
+	price := func(s stock) int { return s.price }
+
+	values, err := Collect(
+		AverageBy(price)(Just(stock{"a", 1}, stock{"b", 2}, stock{"c", 3})),
+	)
+	is.Equal([]float64{2}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		AverageBy(price)(Empty[stock]()),
+	)
+	is.True(math.IsNaN(values[0]))
+	is.NoError(err)
+
+	values, err = Collect(
+		AverageBy(price)(Throw[stock](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorMathCount(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -79,6 +111,129 @@ func TestOperatorMathCount(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorMathCountWhere(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		CountWhere(func(item int) bool { return item%2 == 0 })(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]int64{2}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CountWhere(func(item int) bool { return item > 100 })(Just(1, 2, 3)),
+	)
+	is.Equal([]int64{0}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CountWhere(func(item int) bool { return true })(Empty[int]()),
+	)
+	is.Equal([]int64{0}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CountWhere(func(item int) bool { return true })(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathCountBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		CountBy(func(item int) bool { return item%2 == 0 })(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]int64{2}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CountBy(func(item int) bool { return true })(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// matching/total ratio from separate pipes over the same source
+	source := Just(1, 2, 3, 4, 5)
+	ratios, err := Collect(
+		CombineLatestInto(
+			Pipe1(source, CountBy(func(item int) bool { return item%2 == 0 })),
+			Pipe1(source, Count[int]()),
+			func(matching int64, total int64) float64 {
+				return float64(matching) / float64(total)
+			},
+		),
+	)
+	is.Equal([]float64{0.4}, ratios)
+	is.NoError(err)
+}
+
+func TestOperatorMathCountDistinct(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		CountDistinct[int]()(Just(1, 2, 2, 3, 1)),
+	)
+	is.Equal([]int64{3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CountDistinct[int]()(Empty[int]()),
+	)
+	is.Equal([]int64{0}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CountDistinct[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathRunningCount(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		RunningCount[int]()(Just(10, 20, 30)),
+	)
+	is.Equal([]int64{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		RunningCount[int]()(Empty[int]()),
+	)
+	is.Equal([]int64{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		RunningCount[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathRunningCountWhere(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		RunningCountWhere(func(item int) bool { return item%2 == 0 })(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]int64{1, 2}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		RunningCountWhere(func(item int) bool { return true })(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorMathSum(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -102,6 +257,185 @@ func TestOperatorMathSum(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorMathCountByKey(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	parity := func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+
+		return "odd"
+	}
+
+	values, err := Collect(
+		CountByKey(parity)(Just(1, 2, 3, 4, 5)),
+	)
+	is.Equal([]map[string]int64{{"even": 2, "odd": 3}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CountByKey(parity)(Empty[int]()),
+	)
+	is.Equal([]map[string]int64{{}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CountByKey(parity)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]map[string]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathSumBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type stock struct {
+		name  string
+		price int
+	}
+
+	price := func(s stock) int { return s.price }
+
+	values, err := Collect(
+		SumBy(price)(Just(stock{"a", 1}, stock{"b", 2}, stock{"c", 3})),
+	)
+	is.Equal([]int{6}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		SumBy(price)(Empty[stock]()),
+	)
+	is.Equal([]int{0}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		SumBy(price)(Throw[stock](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathDelta(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Delta[int]()(Just(10, 12, 11, 20)),
+	)
+	is.Equal([]int{2, -1, 9}, values)
+	is.NoError(err)
+
+	// a single value has no predecessor
+	values, err = Collect(
+		Delta[int]()(Just(42)),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Delta[int]()(Empty[int]()),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Delta[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathDeltaBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type sample struct {
+		total int
+	}
+
+	values, err := Collect(
+		DeltaBy(func(s sample) int { return s.total })(Just(sample{10}, sample{15}, sample{30})),
+	)
+	is.Equal([]int{5, 15}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		DeltaBy(func(s sample) int { return s.total })(Empty[sample]()),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		DeltaBy(func(s sample) int { return s.total })(Throw[sample](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathPercentile(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// running median
+	values, err := Collect(
+		Percentile[int](50, time.Minute)(Just(1, 2, 3, 4, 5)),
+	)
+	is.Equal([]float64{1, 1.5, 2, 2.5, 3}, values)
+	is.NoError(err)
+
+	// p0 is the running min, p100 the running max
+	values, err = Collect(
+		Percentile[int](0, time.Minute)(Just(3, 1, 2)),
+	)
+	is.Equal([]float64{3, 1, 1}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Percentile[int](100, time.Minute)(Just(3, 1, 2)),
+	)
+	is.Equal([]float64{3, 3, 3}, values)
+	is.NoError(err)
+
+	// samples older than the window are evicted
+	source := NewPublishSubject[int]()
+	output := []float64{}
+
+	sub := Percentile[int](100, 10*time.Millisecond)(source.AsObservable()).
+		Subscribe(NewObserver(
+			func(value float64) { output = append(output, value) },
+			func(err error) {},
+			func() {},
+		))
+
+	source.Next(100)
+	time.Sleep(30 * time.Millisecond)
+	source.Next(1)
+	source.Complete()
+	sub.Unsubscribe()
+	sub.Wait()
+
+	is.Equal([]float64{100, 1}, output)
+
+	values, err = Collect(
+		Percentile[int](95, time.Minute)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrPercentileWrongPercentile.Error(), func() {
+		Percentile[int](-1, time.Minute)
+	})
+	is.PanicsWithError(ErrPercentileWrongPercentile.Error(), func() {
+		Percentile[int](101, time.Minute)
+	})
+	is.PanicsWithError(ErrPercentileWrongWindow.Error(), func() {
+		Percentile[int](95, 0)
+	})
+}
+
 func TestOperatorMathRound(t *testing.T) { //nolint:paralleltest
 	// @TODO: implement
 }
@@ -164,6 +498,267 @@ func TestOperatorMathMax(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorMathPercentileAll(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		PercentileAll[int](50)(Just(1, 2, 3, 4, 5)),
+	)
+	is.Equal([]float64{3}, values)
+	is.NoError(err)
+
+	// linear interpolation between the two nearest ranks
+	values, err = Collect(
+		PercentileAll[int](75)(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]float64{3.25}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		PercentileAll[int](100)(Just(3, 1, 2)),
+	)
+	is.Equal([]float64{3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		PercentileAll[int](50)(Empty[int]()),
+	)
+	is.True(math.IsNaN(values[0]))
+	is.NoError(err)
+
+	values, err = Collect(
+		PercentileAll[int](50)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrPercentileAllWrongPercentile.Error(), func() {
+		PercentileAll[int](0)
+	})
+	is.PanicsWithError(ErrPercentileAllWrongPercentile.Error(), func() {
+		PercentileAll[int](101)
+	})
+}
+
+func TestOperatorMathMedian(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Median[int]()(Just(5, 1, 3)),
+	)
+	is.Equal([]float64{3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Median[int]()(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]float64{2.5}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Median[int]()(Empty[int]()),
+	)
+	is.True(math.IsNaN(values[0]))
+	is.NoError(err)
+
+	values, err = Collect(
+		Median[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathStdDev(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		StdDev[int]()(Just(2, 4, 4, 4, 5, 5, 7, 9)),
+	)
+	is.Equal([]float64{2}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		StdDev[int]()(Just(42)),
+	)
+	is.Equal([]float64{0}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		StdDev[int]()(Empty[int]()),
+	)
+	is.True(math.IsNaN(values[0]))
+	is.NoError(err)
+
+	values, err = Collect(
+		StdDev[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathMinBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type stock struct {
+		name  string
+		price int
+	}
+
+	cheaper := func(a, b stock) bool { return a.price < b.price }
+
+	values, err := Collect(
+		MinBy(cheaper)(Just(stock{"a", 3}, stock{"b", 1}, stock{"c", 2})),
+	)
+	is.Equal([]stock{{"b", 1}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinBy(cheaper)(Empty[stock]()),
+	)
+	is.Equal([]stock{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinBy(cheaper)(Throw[stock](assert.AnError)),
+	)
+	is.Equal([]stock{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathMaxBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type stock struct {
+		name  string
+		price int
+	}
+
+	cheaper := func(a, b stock) bool { return a.price < b.price }
+
+	values, err := Collect(
+		MaxBy(cheaper)(Just(stock{"a", 3}, stock{"b", 1}, stock{"c", 2})),
+	)
+	is.Equal([]stock{{"a", 3}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MaxBy(cheaper)(Empty[stock]()),
+	)
+	is.Equal([]stock{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MaxBy(cheaper)(Throw[stock](assert.AnError)),
+	)
+	is.Equal([]stock{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathMinByKey(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type stock struct {
+		name  string
+		price int
+	}
+
+	price := func(s stock) int { return s.price }
+
+	values, err := Collect(
+		MinByKey(price)(Just(stock{"a", 3}, stock{"b", 1}, stock{"c", 2})),
+	)
+	is.Equal([]stock{{"b", 1}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinByKey(price)(Empty[stock]()),
+	)
+	is.Equal([]stock{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinByKey(price)(Throw[stock](assert.AnError)),
+	)
+	is.Equal([]stock{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathMaxByKey(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type stock struct {
+		name  string
+		price int
+	}
+
+	price := func(s stock) int { return s.price }
+
+	values, err := Collect(
+		MaxByKey(price)(Just(stock{"a", 3}, stock{"b", 1}, stock{"c", 2})),
+	)
+	is.Equal([]stock{{"a", 3}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MaxByKey(price)(Empty[stock]()),
+	)
+	is.Equal([]stock{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MaxByKey(price)(Throw[stock](assert.AnError)),
+	)
+	is.Equal([]stock{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathRunningMinMax(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		RunningMinMax[int]()(Just(3, 1, 4, 1, 5)),
+	)
+	is.Equal([]lo.Tuple3[int, int, int]{
+		lo.T3(3, 3, 3),
+		lo.T3(1, 1, 3),
+		lo.T3(4, 1, 4),
+		lo.T3(1, 1, 4),
+		lo.T3(5, 1, 5),
+	}, values)
+	is.NoError(err)
+
+	// strings are ordered too
+	words, err := Collect(
+		RunningMinMax[string]()(Just("b", "a", "c")),
+	)
+	is.Equal([]lo.Tuple3[string, string, string]{
+		lo.T3("b", "b", "b"),
+		lo.T3("a", "a", "b"),
+		lo.T3("c", "a", "c"),
+	}, words)
+	is.NoError(err)
+
+	values, err = Collect(
+		RunningMinMax[int]()(Empty[int]()),
+	)
+	is.Equal([]lo.Tuple3[int, int, int]{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		RunningMinMax[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]lo.Tuple3[int, int, int]{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorMathClamp(t *testing.T) { //nolint:paralleltest
 	// @TODO: implement
 }
@@ -211,6 +806,63 @@ func TestOperatorMathReduce(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorMathReduceErr(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reducer := func(agg, current int) (int, error) {
+		if current < 0 {
+			return 0, assert.AnError
+		}
+
+		return agg + current, nil
+	}
+
+	values, err := Collect(
+		ReduceErr(reducer, 10)(Just(1, 2, 3)),
+	)
+	is.Equal([]int{16}, values)
+	is.NoError(err)
+
+	// the stream terminates on the first reducer failure
+	values, err = Collect(
+		ReduceErr(reducer, 10)(Just(1, -1, 3)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		ReduceErr(reducer, 10)(Empty[int]()),
+	)
+	is.Equal([]int{10}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ReduceErr(reducer, 10)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathReduceWithIndex(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	obs := ReduceWithIndex(func(agg, current, i int) int {
+		is.Equal(current, i)
+		return agg + current
+	}, 10)(Just(0, 1, 2, 3))
+
+	values, err := Collect(obs)
+	is.Equal([]int{16}, values)
+	is.NoError(err)
+
+	// index resets on resubscription
+	values, err = Collect(obs)
+	is.Equal([]int{16}, values)
+	is.NoError(err)
+}
+
 func TestOperatorMathReduceI(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
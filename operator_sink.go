@@ -23,8 +23,14 @@ import (
 // ToSlice collects all items from the observable into a slice. It is a sink
 // operator so it emit a single value. It emits the slice when the source
 // completes. If the source is empty, it emits an empty slice.
+// An optional capacity hint can be provided to pre-size the accumulator
+// slice, like ToSliceWithCapacity.
 // Play: https://go.dev/play/p/kxbU_PzpN6t
-func ToSlice[T any]() func(Observable[T]) Observable[[]T] {
+func ToSlice[T any](capacity ...int) func(Observable[T]) Observable[[]T] {
+	if len(capacity) > 0 {
+		return ToSliceWithCapacity[T](capacity[0])
+	}
+
 	return func(source Observable[T]) Observable[[]T] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
 			slice := []T{}
@@ -48,6 +54,80 @@ func ToSlice[T any]() func(Observable[T]) Observable[[]T] {
 	}
 }
 
+// ToSliceWithCapacity is like ToSlice, but preallocates the accumulator slice
+// with the provided capacity hint. Use it when the approximate item count is
+// known upfront (e.g. a row count from a query) to avoid repeated slice
+// growth. The capacity is only a hint: the stream may emit fewer or more
+// items.
+func ToSliceWithCapacity[T any](capacity int) func(Observable[T]) Observable[[]T] {
+	if capacity < 0 {
+		panic(ErrToSliceWithCapacityWrongCapacity)
+	}
+
+	return func(source Observable[T]) Observable[[]T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
+			slice := make([]T, 0, capacity)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						slice = append(slice, value)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, slice)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// ToSliceWithCap is like ToSlice, but errors with ErrToSliceExceededMaxItems
+// once more than maxItems items have been received, as a safety valve against
+// unbounded sources. A maxItems of 0 means unbounded, ie: the behavior of
+// ToSlice.
+func ToSliceWithCap[T any](maxItems int) func(Observable[T]) Observable[[]T] {
+	if maxItems < 0 {
+		panic(ErrToSliceWithCapWrongMaxItems)
+	}
+
+	if maxItems == 0 {
+		return ToSlice[T]()
+	}
+
+	return func(source Observable[T]) Observable[[]T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
+			slice := []T{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if len(slice) >= maxItems {
+							destination.ErrorWithContext(ctx, ErrToSliceExceededMaxItems)
+							return
+						}
+
+						slice = append(slice, value)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, slice)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // ToMap collects all items from the observable into a map. It is a sink
 // operator so it emit a single value. It emits the map when the source
 // completes. If the source is empty, it emits an empty map.
@@ -109,6 +189,73 @@ func ToMapIWithContext[T any, K comparable, V any](mapper func(ctx context.Conte
 	}
 }
 
+// ToMapStrict is like ToMap, but errors with ErrToMapDuplicateKey when two
+// items produce the same key, instead of silently overwriting the previous
+// value. Use it when a collision means data loss, e.g. on primary keys.
+func ToMapStrict[T any, K comparable, V any](project func(item T) (K, V)) func(Observable[T]) Observable[map[K]V] {
+	return func(source Observable[T]) Observable[map[K]V] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[map[K]V]) Teardown {
+			output := map[K]V{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						k, v := project(value)
+						if _, ok := output[k]; ok {
+							destination.ErrorWithContext(ctx, ErrToMapDuplicateKey)
+							return
+						}
+
+						output[k] = v
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, output)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// GroupToMap collects all items from the observable into a map of slices,
+// grouping the values that share the same key, in emission order. It is a
+// sink operator so it emit a single value. It emits the map when the source
+// completes. If the source is empty, it emits an empty map.
+func GroupToMap[T any, K comparable, V any](project func(item T) (K, V)) func(Observable[T]) Observable[map[K][]V] {
+	return func(source Observable[T]) Observable[map[K][]V] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[map[K][]V]) Teardown {
+			output := map[K][]V{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						k, v := project(value)
+						output[k] = append(output[k], v)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, output)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// ToMultiMap is an alias to GroupToMap.
+func ToMultiMap[T any, K comparable, V any](project func(item T) (K, V)) func(Observable[T]) Observable[map[K][]V] {
+	return GroupToMap(project)
+}
+
 // ToChannel materializes and forward all items from the observable into a
 // channel. It is a sink operator so it emit a single value. It emits the
 // channel when the source completes. If the source is empty, it emits an
@@ -179,3 +326,124 @@ func ToChannel[T any](size int) func(Observable[T]) Observable[<-chan Notificati
 		})
 	}
 }
+
+// ToChannelBatched is like ToChannel, but coalesces notifications into
+// batches before sending, reducing channel-send overhead when the consumer
+// can process in bulk. A batch is sent when it reaches maxBatch notifications
+// or when maxDelay has elapsed since the last send, whichever comes first.
+// The final partial batch, holding the terminal notification, is flushed
+// before the channel is closed.
+func ToChannelBatched[T any](maxBatch int, maxDelay time.Duration) func(Observable[T]) Observable[<-chan []Notification[T]] {
+	if maxBatch < 1 {
+		panic(ErrToChannelBatchedWrongMaxBatch)
+	}
+
+	if maxDelay <= 0 {
+		panic(ErrToChannelBatchedWrongMaxDelay)
+	}
+
+	return func(source Observable[T]) Observable[<-chan []Notification[T]] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[<-chan []Notification[T]]) Teardown {
+			ch := make(chan []Notification[T], 1)
+
+			mu := sync.Mutex{}
+			pending := []Notification[T]{}
+
+			once := sync.Once{}
+			closeChan := func() {
+				once.Do(func() {
+					close(ch)
+				})
+			}
+
+			// The channel send happens under the mutex, so concurrent flushes
+			// from the ticker and the source cannot reorder batches.
+			flush := func() {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if len(pending) == 0 {
+					return
+				}
+
+				batch := pending
+				pending = []Notification[T]{}
+				ch <- batch
+			}
+
+			terminal := func(notification Notification[T]) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				batch := append(pending, notification)
+				pending = nil
+				ch <- batch
+
+				closeChan()
+			}
+
+			ticker := time.NewTicker(maxDelay)
+			done := make(chan struct{})
+
+			go recoverUnhandledError(func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-ticker.C:
+						flush()
+					}
+				}
+			})
+
+			subscriptions := NewSubscription(nil)
+
+			// Same detachment rationale as ToChannel: the channel is handed to
+			// the observer before subscribing, because the next operator might
+			// be long-running.
+			go func() {
+				// This is a workaround to avoid a race condition between the
+				// destination.NextWithContext() and the destination.CompleteWithContext()
+				// on empty source.
+				time.Sleep(1 * time.Millisecond)
+
+				subscriptions.AddUnsubscribable(
+					source.SubscribeWithContext(
+						subscriberCtx,
+						NewObserverWithContext(
+							func(ctx context.Context, value T) {
+								mu.Lock()
+								defer mu.Unlock()
+
+								pending = append(pending, NewNotificationNext(value))
+
+								if len(pending) >= maxBatch {
+									batch := pending
+									pending = []Notification[T]{}
+									ch <- batch
+								}
+							},
+							func(ctx context.Context, err error) {
+								terminal(NewNotificationError[T](err))
+								destination.CompleteWithContext(ctx)
+							},
+							func(ctx context.Context) {
+								terminal(NewNotificationComplete[T]())
+								destination.CompleteWithContext(ctx)
+							},
+						),
+					),
+				)
+			}()
+
+			destination.NextWithContext(context.TODO(), ch)
+
+			return func() {
+				ticker.Stop()
+				close(done)
+				subscriptions.Unsubscribe()
+				closeChan()
+			}
+		})
+	}
+}
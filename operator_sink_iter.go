@@ -0,0 +1,175 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+
+package ro
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// ToSeq bridges an Observable back to pull-based Go code as a range-over-func
+// iterator. The source is subscribed when the range loop starts and items are
+// delivered one by one: the producer blocks until the loop body asks for the
+// next item. Breaking out of the loop unsubscribes from the source. The loop
+// ends when the source completes or errors; errors are dropped, use ToSeq2 to
+// observe them.
+func ToSeq[T any]() func(Observable[T]) iter.Seq[T] {
+	return func(source Observable[T]) iter.Seq[T] {
+		return func(yield func(T) bool) {
+			for notification := range toNotificationSeq(source) {
+				if notification.Kind != KindNext {
+					return
+				}
+
+				if !yield(notification.Value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ToSeq2 bridges an Observable back to pull-based Go code as a range-over-func
+// iterator yielding (value, error) pairs. Each item is yielded with a nil
+// error; if the source errors, a final (zero value, error) pair is yielded
+// before iteration stops. Breaking out of the loop unsubscribes from the
+// source.
+func ToSeq2[T any]() func(Observable[T]) iter.Seq2[T, error] {
+	return func(source Observable[T]) iter.Seq2[T, error] {
+		return func(yield func(T, error) bool) {
+			for notification := range toNotificationSeq(source) {
+				switch notification.Kind {
+				case KindNext:
+					if !yield(notification.Value, nil) {
+						return
+					}
+				case KindError:
+					var zero T
+					yield(zero, notification.Err)
+
+					return
+				case KindComplete:
+					return
+				}
+			}
+		}
+	}
+}
+
+// ToSeqWithError bridges an Observable back to pull-based Go code as a
+// range-over-func iterator, subscribing with the provided context. It also
+// returns a function reporting the terminal error, meaningful once the range
+// loop has finished: nil on completion or early break, non-nil if the source
+// errored. Breaking out of the loop unsubscribes from the source.
+func ToSeqWithError[T any](ctx context.Context, observable Observable[T]) (iter.Seq[T], func() error) {
+	var mu sync.Mutex
+
+	var terminal error
+
+	seq := func(yield func(T) bool) {
+		for notification := range toNotificationSeqWithContext(ctx, observable) {
+			switch notification.Kind {
+			case KindNext:
+				if !yield(notification.Value) {
+					return
+				}
+			case KindError:
+				mu.Lock()
+				terminal = notification.Err
+				mu.Unlock()
+
+				return
+			case KindComplete:
+				return
+			}
+		}
+	}
+
+	getErr := func() error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return terminal
+	}
+
+	return seq, getErr
+}
+
+// toNotificationSeq subscribes to the source on a dedicated goroutine (many
+// sources emit synchronously during Subscribe) and hands notifications over an
+// unbuffered channel, so the producer advances in lock-step with the consumer.
+func toNotificationSeq[T any](source Observable[T]) iter.Seq[Notification[T]] {
+	return toNotificationSeqWithContext(context.Background(), source)
+}
+
+func toNotificationSeqWithContext[T any](subscriberCtx context.Context, source Observable[T]) iter.Seq[Notification[T]] {
+	return func(yield func(Notification[T]) bool) {
+		ch := make(chan Notification[T])
+		done := make(chan struct{})
+
+		once := sync.Once{}
+		stop := func() {
+			once.Do(func() {
+				close(done)
+			})
+		}
+
+		forward := func(notification Notification[T]) {
+			select {
+			case ch <- notification:
+			case <-done:
+			}
+		}
+
+		subscriptions := NewSubscription(nil)
+
+		go func() {
+			subscriptions.AddUnsubscribable(
+				source.SubscribeWithContext(
+					subscriberCtx,
+					NewObserver(
+						func(value T) {
+							forward(NewNotificationNext(value))
+						},
+						func(err error) {
+							forward(NewNotificationError[T](err))
+						},
+						func() {
+							forward(NewNotificationComplete[T]())
+						},
+					),
+				),
+			)
+		}()
+
+		defer subscriptions.Unsubscribe()
+		defer stop()
+
+		for {
+			notification := <-ch
+
+			if !yield(notification) {
+				return
+			}
+
+			if notification.Kind != KindNext {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+
+package ro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperatorSinkToSeq(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	values := []int{}
+	for v := range ToSeq[int]()(Just(1, 2, 3)) {
+		values = append(values, v)
+	}
+	is.Equal([]int{1, 2, 3}, values)
+
+	// breaking out of the loop unsubscribes from the source
+	values = []int{}
+	for v := range ToSeq[int]()(Just(1, 2, 3)) {
+		values = append(values, v)
+		if len(values) == 2 {
+			break
+		}
+	}
+	is.Equal([]int{1, 2}, values)
+
+	// empty
+	values = []int{}
+	for v := range ToSeq[int]()(Empty[int]()) {
+		values = append(values, v)
+	}
+	is.Equal([]int{}, values)
+
+	// errors stop iteration silently
+	values = []int{}
+	for v := range ToSeq[int]()(Throw[int](assert.AnError)) {
+		values = append(values, v)
+	}
+	is.Equal([]int{}, values)
+}
+
+func TestOperatorSinkToSeq2(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	values := []int{}
+	errs := []error{}
+	for v, err := range ToSeq2[int]()(Just(1, 2, 3)) {
+		values = append(values, v)
+		errs = append(errs, err)
+	}
+	is.Equal([]int{1, 2, 3}, values)
+	is.Equal([]error{nil, nil, nil}, errs)
+
+	// an error is yielded as a final (zero value, error) pair
+	values = []int{}
+	errs = []error{}
+	for v, err := range ToSeq2[int]()(Concat(Just(1, 2), Throw[int](assert.AnError))) {
+		values = append(values, v)
+		errs = append(errs, err)
+	}
+	is.Equal([]int{1, 2, 0}, values)
+	is.Equal([]error{nil, nil, assert.AnError}, errs)
+
+	// breaking out of the loop unsubscribes from the source
+	values = []int{}
+	for v, err := range ToSeq2[int]()(Just(1, 2, 3)) {
+		is.NoError(err)
+		values = append(values, v)
+		break
+	}
+	is.Equal([]int{1}, values)
+}
+
+func TestOperatorSinkToSeqWithError(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	seq, getErr := ToSeqWithError(context.Background(), Just(1, 2, 3))
+
+	values := []int{}
+	for v := range seq {
+		values = append(values, v)
+	}
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(getErr())
+
+	// the terminal error is available once iteration has finished
+	seq, getErr = ToSeqWithError(context.Background(), Concat(Just(1, 2), Throw[int](assert.AnError)))
+
+	values = []int{}
+	for v := range seq {
+		values = append(values, v)
+	}
+	is.Equal([]int{1, 2}, values)
+	is.EqualError(getErr(), assert.AnError.Error())
+
+	// breaking out of the loop unsubscribes and reports no error
+	seq, getErr = ToSeqWithError(context.Background(), Just(1, 2, 3))
+
+	values = []int{}
+	for v := range seq {
+		values = append(values, v)
+		break
+	}
+	is.Equal([]int{1}, values)
+	is.NoError(getErr())
+}
@@ -45,6 +45,92 @@ func TestOperatorSinkToSlice(t *testing.T) {
 	)
 	is.Equal([][]int{}, values)
 	is.EqualError(err, assert.AnError.Error())
+
+	// optional capacity hint
+	values, err = Collect(
+		ToSlice[int](10)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{{1, 2, 3}}, values)
+	is.NoError(err)
+	is.Equal(10, cap(values[0]))
+}
+
+func TestOperatorSinkToSliceWithCap(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ToSliceWithCap[int](3)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{{1, 2, 3}}, values)
+	is.NoError(err)
+
+	// the cap is exceeded
+	values, err = Collect(
+		ToSliceWithCap[int](2)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, "ro.ToSlice: exceeded max items")
+
+	// 0 means unbounded
+	values, err = Collect(
+		ToSliceWithCap[int](0)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{{1, 2, 3}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ToSliceWithCap[int](10)(Empty[int]()),
+	)
+	is.Equal([][]int{{}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ToSliceWithCap[int](10)(Throw[int](assert.AnError)),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrToSliceWithCapWrongMaxItems.Error(), func() {
+		ToSliceWithCap[int](-1)
+	})
+}
+
+func TestOperatorSinkToSliceWithCapacity(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ToSliceWithCapacity[int](3)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{{1, 2, 3}}, values)
+	is.NoError(err)
+	is.Equal(3, cap(values[0]))
+
+	// the capacity is only a hint
+	values, err = Collect(
+		ToSliceWithCapacity[int](2)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{{1, 2, 3}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ToSliceWithCapacity[int](10)(Empty[int]()),
+	)
+	is.Equal([][]int{{}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ToSliceWithCapacity[int](10)(Throw[int](assert.AnError)),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrToSliceWithCapacityWrongCapacity.Error(), func() {
+		ToSliceWithCapacity[int](-1)
+	})
 }
 
 func TestOperatorSinkToMap(t *testing.T) {
@@ -112,6 +198,85 @@ func TestOperatorSinkToMapI(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorSinkToMapStrict(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	mapper := func(v int) (string, int) {
+		return strconv.FormatInt(int64(v), 10), v
+	}
+
+	values, err := Collect(
+		ToMapStrict(mapper)(Just(1, 2, 3)),
+	)
+	is.Equal([]map[string]int{{"1": 1, "2": 2, "3": 3}}, values)
+	is.NoError(err)
+
+	// a duplicate key is a hard error
+	values, err = Collect(
+		ToMapStrict(mapper)(Just(1, 2, 1)),
+	)
+	is.Equal([]map[string]int{}, values)
+	is.EqualError(err, "ro.ToMap: duplicate key")
+
+	values, err = Collect(
+		ToMapStrict(mapper)(Empty[int]()),
+	)
+	is.Equal([]map[string]int{{}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ToMapStrict(mapper)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]map[string]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorSinkGroupToMap(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	mapper := func(v int) (string, int) {
+		return strconv.FormatInt(int64(v%2), 10), v
+	}
+
+	values, err := Collect(
+		GroupToMap(mapper)(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]map[string][]int{{"0": {2, 4}, "1": {1, 3}}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		GroupToMap(mapper)(Empty[int]()),
+	)
+	is.Equal([]map[string][]int{{}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		GroupToMap(mapper)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]map[string][]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorSinkToMultiMap(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	mapper := func(v int) (string, int) {
+		return strconv.FormatInt(int64(v%2), 10), v
+	}
+
+	values, err := Collect(
+		ToMultiMap(mapper)(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]map[string][]int{{"0": {2, 4}, "1": {1, 3}}}, values)
+	is.NoError(err)
+}
+
 func TestOperatorSinkToChannel(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -150,3 +315,84 @@ func TestOperatorSinkToChannel(t *testing.T) {
 	}, all)
 	is.NoError(err)
 }
+
+func TestOperatorSinkToChannelBatched(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// the consumer drains the channel while the stream runs
+	collectBatches := func(source Observable[int]) [][]Notification[int] {
+		batches := make(chan [][]Notification[int], 1)
+
+		sub := ToChannelBatched[int](2, time.Second)(source).Subscribe(OnNext(func(ch <-chan []Notification[int]) {
+			go func() {
+				all := [][]Notification[int]{}
+				for batch := range ch {
+					all = append(all, batch)
+				}
+
+				batches <- all
+			}()
+		}))
+
+		all := <-batches
+		sub.Wait()
+
+		return all
+	}
+
+	// batches close on size; the terminal notification rides the final batch
+	is.Equal([][]Notification[int]{
+		{NewNotificationNext(1), NewNotificationNext(2)},
+		{NewNotificationNext(3), NewNotificationNext(4)},
+		{NewNotificationNext(5), NewNotificationComplete[int]()},
+	}, collectBatches(Just(1, 2, 3, 4, 5)))
+
+	is.Equal([][]Notification[int]{
+		{NewNotificationComplete[int]()},
+	}, collectBatches(Empty[int]()))
+
+	is.Equal([][]Notification[int]{
+		{NewNotificationError[int](assert.AnError)},
+	}, collectBatches(Throw[int](assert.AnError)))
+
+	is.PanicsWithError(ErrToChannelBatchedWrongMaxBatch.Error(), func() {
+		ToChannelBatched[int](0, time.Second)
+	})
+	is.PanicsWithError(ErrToChannelBatchedWrongMaxDelay.Error(), func() {
+		ToChannelBatched[int](1, 0)
+	})
+}
+
+func TestOperatorSinkToChannelBatchedDelay(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	subject := NewPublishSubject[int]()
+
+	var ch <-chan []Notification[int]
+
+	sub := Pipe1(
+		subject.AsObservable(),
+		ToChannelBatched[int](10, 30*time.Millisecond),
+	).Subscribe(OnNext(func(c <-chan []Notification[int]) {
+		ch = c
+	}))
+
+	// leave time for the detached goroutine to subscribe to the subject
+	time.Sleep(10 * time.Millisecond)
+
+	// the delay timer flushes a partial batch
+	subject.Next(1)
+	is.Equal([]Notification[int]{NewNotificationNext(1)}, <-ch)
+
+	subject.Complete()
+	is.Equal([]Notification[int]{NewNotificationComplete[int]()}, <-ch)
+
+	_, ok := <-ch
+	is.False(ok)
+
+	sub.Wait()
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/samber/lo"
+	"github.com/samber/ro/internal/constraints"
 	"github.com/samber/ro/internal/xsync"
 	"github.com/samber/ro/internal/xtime"
 )
@@ -33,6 +34,15 @@ func Map[T, R any](project func(item T) R) func(Observable[T]) Observable[R] {
 	})
 }
 
+// MapSame applies a same-type project function to each item emitted by the
+// source Observable and emits the result. It is a convenience for in-place
+// adjustments (eg: precision truncation), reading more naturally than
+// Pipe1(source, Map(project)). Adding a method to the Observable interface
+// instead would break every implementer, so it is provided as a function.
+func MapSame[T any](source Observable[T], project func(item T) T) Observable[T] {
+	return Pipe1(source, Map(project))
+}
+
 // MapWithContext applies a given project function to each item emitted by an Observable and emits the result.
 // Play: https://go.dev/play/p/b6i0jQenObW
 func MapWithContext[T, R any](project func(ctx context.Context, item T) (context.Context, R)) func(Observable[T]) Observable[R] {
@@ -49,6 +59,13 @@ func MapI[T, R any](project func(item T, index int64) R) func(Observable[T]) Obs
 	})
 }
 
+// MapWithIndex is an alias to MapI, with an int index.
+func MapWithIndex[T, R any](project func(item T, index int) R) func(Observable[T]) Observable[R] {
+	return MapI(func(item T, index int64) R {
+		return project(item, int(index))
+	})
+}
+
 // MapIWithContext applies a given project function to each item emitted by an Observable and emits the result.
 // Play: https://go.dev/play/p/dDFC9SU3FF1
 func MapIWithContext[T, R any](project func(ctx context.Context, item T, index int64) (context.Context, R)) func(Observable[T]) Observable[R] {
@@ -153,6 +170,233 @@ func MapErrIWithContext[T, R any](project func(ctx context.Context, item T, inde
 	}
 }
 
+// MapConcurrent applies a given project function to the items emitted by an
+// Observable, processing up to `concurrency` items in parallel, and emits the
+// results in input order. Out-of-order completions are buffered until the
+// preceding results are available. When a worker returns an error, the error
+// is propagated and the results of outstanding workers are dropped. When all
+// workers are busy, the producer is blocked.
+func MapConcurrent[T, R any](project func(item T) (R, error), concurrency int) func(Observable[T]) Observable[R] {
+	if concurrency < 1 {
+		panic(ErrMapConcurrentWrongConcurrency)
+	}
+
+	return func(source Observable[T]) Observable[R] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[R]) Teardown {
+			mu := xsync.NewMutexWithLock()
+			sem := make(chan struct{}, concurrency)
+
+			results := map[int64]lo.Tuple2[context.Context, R]{}
+			index := int64(0)
+			nextEmit := int64(0)
+			pending := int64(0)
+			completed := false
+			failed := false
+
+			// Called under mu. Emits the buffered results that are next in
+			// input order, then reports whether the stream can complete.
+			flush := func() bool {
+				for {
+					result, ok := results[nextEmit]
+					if !ok {
+						break
+					}
+
+					delete(results, nextEmit)
+					nextEmit++
+
+					destination.NextWithContext(result.A, result.B)
+				}
+
+				return completed && pending == 0 && len(results) == 0
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						mu.Lock()
+
+						if failed {
+							mu.Unlock()
+							return
+						}
+
+						i := index
+						index++
+						pending++
+
+						mu.Unlock()
+
+						sem <- struct{}{}
+
+						go recoverUnhandledError(func() {
+							result, err := project(value)
+
+							<-sem
+
+							mu.Lock()
+
+							pending--
+
+							if failed {
+								mu.Unlock()
+								return
+							}
+
+							if err != nil {
+								failed = true
+								mu.Unlock()
+								destination.ErrorWithContext(ctx, err)
+
+								return
+							}
+
+							results[i] = lo.T2(ctx, result)
+							done := flush()
+
+							mu.Unlock()
+
+							if done {
+								destination.CompleteWithContext(ctx)
+							}
+						})
+					},
+					func(ctx context.Context, err error) {
+						mu.Lock()
+
+						if failed {
+							mu.Unlock()
+							return
+						}
+
+						failed = true
+
+						mu.Unlock()
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+
+						completed = true
+						done := !failed && pending == 0 && len(results) == 0
+
+						mu.Unlock()
+
+						if done {
+							destination.CompleteWithContext(ctx)
+						}
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// MapConcurrentUnordered applies a given project function to the items emitted
+// by an Observable, processing up to `concurrency` items in parallel, and
+// emits the results as soon as they are available, in completion order. Use
+// MapConcurrent to preserve the input order. When a worker returns an error,
+// the error is propagated and the results of outstanding workers are dropped.
+// When all workers are busy, the producer is blocked.
+func MapConcurrentUnordered[T, R any](project func(item T) (R, error), concurrency int) func(Observable[T]) Observable[R] {
+	if concurrency < 1 {
+		panic(ErrMapConcurrentUnorderedWrongConcurrency)
+	}
+
+	return func(source Observable[T]) Observable[R] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[R]) Teardown {
+			mu := xsync.NewMutexWithLock()
+			sem := make(chan struct{}, concurrency)
+
+			pending := int64(0)
+			completed := false
+			failed := false
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						mu.Lock()
+
+						if failed {
+							mu.Unlock()
+							return
+						}
+
+						pending++
+
+						mu.Unlock()
+
+						sem <- struct{}{}
+
+						go recoverUnhandledError(func() {
+							result, err := project(value)
+
+							<-sem
+
+							mu.Lock()
+
+							pending--
+
+							if failed {
+								mu.Unlock()
+								return
+							}
+
+							if err != nil {
+								failed = true
+								mu.Unlock()
+								destination.ErrorWithContext(ctx, err)
+
+								return
+							}
+
+							done := completed && pending == 0
+
+							destination.NextWithContext(ctx, result)
+							mu.Unlock()
+
+							if done {
+								destination.CompleteWithContext(ctx)
+							}
+						})
+					},
+					func(ctx context.Context, err error) {
+						mu.Lock()
+
+						if failed {
+							mu.Unlock()
+							return
+						}
+
+						failed = true
+
+						mu.Unlock()
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+
+						completed = true
+						done := !failed && pending == 0
+
+						mu.Unlock()
+
+						if done {
+							destination.CompleteWithContext(ctx)
+						}
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // FlatMap transforms the items emitted by an Observable into Observables,
 // then flatten the emissions from those into a single Observable.
 // Play: https://go.dev/play/p/QBkDMwskibT
@@ -208,6 +452,75 @@ func FlatMapIWithContext[T, R any](project func(ctx context.Context, item T, ind
 	}
 }
 
+// FlatMapErr transforms the items emitted by an Observable into Observables
+// using a projection that can fail, then flatten the emissions from those
+// into a single Observable. When the projection returns an error, it is
+// converted into an Error notification, mirroring MapErr semantics, and
+// subsequent items are ignored.
+func FlatMapErr[T, R any](project func(item T) (Observable[R], error)) func(Observable[T]) Observable[R] {
+	return FlatMapErrIWithContext(func(ctx context.Context, v T, _ int64) (Observable[R], error) {
+		return project(v)
+	})
+}
+
+// FlatMapErrWithContext transforms the items emitted by an Observable into
+// Observables using a projection that can fail, then flatten the emissions
+// from those into a single Observable. When the projection returns an error,
+// it is converted into an Error notification, mirroring MapErr semantics, and
+// subsequent items are ignored.
+func FlatMapErrWithContext[T, R any](project func(ctx context.Context, item T) (Observable[R], error)) func(Observable[T]) Observable[R] {
+	return FlatMapErrIWithContext(func(ctx context.Context, v T, _ int64) (Observable[R], error) {
+		return project(ctx, v)
+	})
+}
+
+// FlatMapErrI transforms the items emitted by an Observable into Observables
+// using a projection that can fail, then flatten the emissions from those
+// into a single Observable. When the projection returns an error, it is
+// converted into an Error notification, mirroring MapErr semantics, and
+// subsequent items are ignored.
+func FlatMapErrI[T, R any](project func(item T, index int64) (Observable[R], error)) func(Observable[T]) Observable[R] {
+	return FlatMapErrIWithContext(func(ctx context.Context, v T, i int64) (Observable[R], error) {
+		return project(v, i)
+	})
+}
+
+// FlatMapErrIWithContext transforms the items emitted by an Observable into
+// Observables using a projection that can fail, then flatten the emissions
+// from those into a single Observable. When the projection returns an error,
+// it is converted into an Error notification, mirroring MapErr semantics, and
+// subsequent items are ignored.
+func FlatMapErrIWithContext[T, R any](project func(ctx context.Context, item T, index int64) (Observable[R], error)) func(Observable[T]) Observable[R] {
+	return func(source Observable[T]) Observable[R] {
+		return ConcatAll[R]()(
+			NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[Observable[R]]) Teardown {
+				i := int64(0)
+
+				sub := source.SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, value T) {
+							obs, err := project(ctx, value, i)
+							i++
+
+							if err != nil {
+								destination.ErrorWithContext(ctx, err)
+								return
+							}
+
+							destination.NextWithContext(ctx, obs)
+						},
+						destination.ErrorWithContext,
+						destination.CompleteWithContext,
+					),
+				)
+
+				return sub.Unsubscribe
+			}),
+		)
+	}
+}
+
 // Flatten flattens an Observable of Observables into a single Observable.
 // Play: https://go.dev/play/p/vUyrQ4GO87S
 func Flatten[T any]() func(Observable[[]T]) Observable[T] {
@@ -231,6 +544,54 @@ func Flatten[T any]() func(Observable[[]T]) Observable[T] {
 	}
 }
 
+// FlattenMap flattens an Observable of maps into a single Observable of
+// key-value pairs. The iteration order within each map is non-deterministic.
+// Errors forward unchanged.
+func FlattenMap[K comparable, V any]() func(Observable[map[K]V]) Observable[lo.Tuple2[K, V]] {
+	return func(source Observable[map[K]V]) Observable[lo.Tuple2[K, V]] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[lo.Tuple2[K, V]]) Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value map[K]V) {
+						for k, v := range value {
+							destination.NextWithContext(ctx, lo.T2(k, v))
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// FlattenChannel flattens an Observable of channels into a single Observable.
+// Each channel is drained until it is closed, so an unclosed channel blocks
+// the stream. Errors forward unchanged.
+func FlattenChannel[T any]() func(Observable[chan T]) Observable[T] {
+	return func(source Observable[chan T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value chan T) {
+						for v := range value {
+							destination.NextWithContext(ctx, v)
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // Cast converts each value emitted by an Observable into a specified type.
 // Play: https://go.dev/play/p/XUdqodfFyT6
 func Cast[T, U any]() func(Observable[T]) Observable[U] {
@@ -305,60 +666,154 @@ func ScanIWithContext[T, R any](reduce func(ctx context.Context, accumulator R,
 	}
 }
 
-// GroupBy groups the items emitted by an Observable according to a specified criterion,
-// and emits these grouped items as Observables.
-// Play: https://go.dev/play/p/GOL8imC0H5S
-func GroupBy[T any, K comparable](iteratee func(item T) K) func(Observable[T]) Observable[Observable[T]] {
-	return GroupByIWithContext(func(ctx context.Context, item T, _ int64) (context.Context, K) {
-		return ctx, iteratee(item)
-	})
-}
+// ScanErr applies a fallible accumulator function over an Observable and emits
+// each intermediate result. When the accumulator returns an error, the stream
+// terminates with an error notification, mirroring MapErr semantics, and
+// subsequent items are ignored.
+func ScanErr[T, R any](reduce func(accumulator R, item T) (R, error), seed R) func(Observable[T]) Observable[R] {
+	return func(source Observable[T]) Observable[R] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[R]) Teardown {
+			accumulator := seed
 
-// GroupByWithContext groups the items emitted by an Observable according to a specified criterion,
-// and emits these grouped items as Observables.
-func GroupByWithContext[T any, K comparable](iteratee func(ctx context.Context, item T) (context.Context, K)) func(Observable[T]) Observable[Observable[T]] {
-	return GroupByIWithContext(func(ctx context.Context, item T, _ int64) (context.Context, K) {
-		return iteratee(ctx, item)
-	})
-}
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						acc, err := reduce(accumulator, value)
+						if err != nil {
+							destination.ErrorWithContext(ctx, err)
+							return
+						}
 
-// GroupByI groups the items emitted by an Observable according to a specified criterion,
-// and emits these grouped items as Observables.
-func GroupByI[T any, K comparable](iteratee func(item T, index int64) K) func(Observable[T]) Observable[Observable[T]] {
-	return GroupByIWithContext(func(ctx context.Context, item T, index int64) (context.Context, K) {
-		return ctx, iteratee(item, index)
-	})
+						accumulator = acc
+
+						destination.NextWithContext(ctx, accumulator)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
 }
 
-// GroupByIWithContext groups the items emitted by an Observable according to a specified criterion,
-// and emits these grouped items as Observables.
-// Play: https://go.dev/play/p/h7vpeD0djre
-func GroupByIWithContext[T any, K comparable](iteratee func(ctx context.Context, item T, index int64) (context.Context, K)) func(Observable[T]) Observable[Observable[T]] {
-	return func(source Observable[T]) Observable[Observable[T]] {
-		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[Observable[T]]) Teardown {
-			groups := sync.Map{}
-			i := int64(0)
+// ScanWithSeedEmit is like Scan, but emits the seed as the first value before
+// any accumulation, matching the RxJS startWith+scan idiom. An empty source
+// emits the seed and completes.
+func ScanWithSeedEmit[T, R any](reduce func(accumulator R, item T) R, seed R) func(Observable[T]) Observable[R] {
+	return func(source Observable[T]) Observable[R] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[R]) Teardown {
+			accumulator := seed
 
-			notifyAll := func(cb func(Observer[T])) {
-				groups.Range(func(key, value any) bool {
-					cb(value.(Observer[T])) //nolint:errcheck,forcetypeassert
-					return true
-				})
-			}
+			destination.NextWithContext(subscriberCtx, accumulator)
 
 			sub := source.SubscribeWithContext(
 				subscriberCtx,
 				NewObserverWithContext(
 					func(ctx context.Context, value T) {
-						ctx, key := iteratee(ctx, value, i)
-						i++
+						accumulator = reduce(accumulator, value)
 
-						g, ok := groups.Load(key)
-						if ok {
-							g.(Observer[T]).NextWithContext(ctx, value) //nolint:errcheck,forcetypeassert
-						} else if !ok {
-							subject := NewUnicastSubject[T](UnicastSubjectUnlimitedBufferSize)
-							groups.Store(key, subject)
+						destination.NextWithContext(ctx, accumulator)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// ScanWith applies an accumulator function over an Observable, using the first
+// emitted item as the initial accumulator, and emits each intermediate result.
+// The first item is emitted as-is. An empty source completes without emitting.
+func ScanWith[T any](reduce func(accumulator T, item T) T) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var accumulator T
+
+			first := true
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if first {
+							accumulator = value
+							first = false
+						} else {
+							accumulator = reduce(accumulator, value)
+						}
+
+						destination.NextWithContext(ctx, accumulator)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// GroupBy groups the items emitted by an Observable according to a specified criterion,
+// and emits these grouped items as Observables.
+// Play: https://go.dev/play/p/GOL8imC0H5S
+func GroupBy[T any, K comparable](iteratee func(item T) K) func(Observable[T]) Observable[Observable[T]] {
+	return GroupByIWithContext(func(ctx context.Context, item T, _ int64) (context.Context, K) {
+		return ctx, iteratee(item)
+	})
+}
+
+// GroupByWithContext groups the items emitted by an Observable according to a specified criterion,
+// and emits these grouped items as Observables.
+func GroupByWithContext[T any, K comparable](iteratee func(ctx context.Context, item T) (context.Context, K)) func(Observable[T]) Observable[Observable[T]] {
+	return GroupByIWithContext(func(ctx context.Context, item T, _ int64) (context.Context, K) {
+		return iteratee(ctx, item)
+	})
+}
+
+// GroupByI groups the items emitted by an Observable according to a specified criterion,
+// and emits these grouped items as Observables.
+func GroupByI[T any, K comparable](iteratee func(item T, index int64) K) func(Observable[T]) Observable[Observable[T]] {
+	return GroupByIWithContext(func(ctx context.Context, item T, index int64) (context.Context, K) {
+		return ctx, iteratee(item, index)
+	})
+}
+
+// GroupByIWithContext groups the items emitted by an Observable according to a specified criterion,
+// and emits these grouped items as Observables.
+// Play: https://go.dev/play/p/h7vpeD0djre
+func GroupByIWithContext[T any, K comparable](iteratee func(ctx context.Context, item T, index int64) (context.Context, K)) func(Observable[T]) Observable[Observable[T]] {
+	return func(source Observable[T]) Observable[Observable[T]] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[Observable[T]]) Teardown {
+			groups := sync.Map{}
+			i := int64(0)
+
+			notifyAll := func(cb func(Observer[T])) {
+				groups.Range(func(key, value any) bool {
+					cb(value.(Observer[T])) //nolint:errcheck,forcetypeassert
+					return true
+				})
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						ctx, key := iteratee(ctx, value, i)
+						i++
+
+						g, ok := groups.Load(key)
+						if ok {
+							g.(Observer[T]).NextWithContext(ctx, value) //nolint:errcheck,forcetypeassert
+						} else if !ok {
+							subject := NewUnicastSubject[T](UnicastSubjectUnlimitedBufferSize)
+							groups.Store(key, subject)
 							subject.NextWithContext(ctx, value)
 							destination.NextWithContext(ctx, subject)
 						}
@@ -388,6 +843,294 @@ func GroupByIWithContext[T any, K comparable](iteratee func(ctx context.Context,
 	}
 }
 
+// GroupedObservable is an Observable emitted by GroupByWithConfig that carries
+// the key of the group it holds, so downstream code does not have to re-derive
+// it from the first element.
+type GroupedObservable[K comparable, T any] interface {
+	Observable[T]
+
+	// Key returns the group key shared by all items of this Observable.
+	Key() K
+}
+
+var _ GroupedObservable[string, int] = (*groupedObservableImpl[string, int])(nil)
+
+type groupedObservableImpl[K comparable, T any] struct {
+	key     K
+	subject Subject[T]
+}
+
+func (g *groupedObservableImpl[K, T]) Key() K {
+	return g.key
+}
+
+func (g *groupedObservableImpl[K, T]) Subscribe(destination Observer[T]) Subscription {
+	return g.subject.Subscribe(destination)
+}
+
+func (g *groupedObservableImpl[K, T]) SubscribeWithContext(ctx context.Context, destination Observer[T]) Subscription {
+	return g.subject.SubscribeWithContext(ctx, destination)
+}
+
+// GroupByConfig tunes the group lifecycle of GroupByWithConfig.
+type GroupByConfig[K comparable] struct {
+	// MaxGroups bounds the number of live groups. When a new key would exceed
+	// the bound, the oldest group is evicted. 0 means unbounded.
+	MaxGroups int
+	// IdleTimeout evicts a group that has not received a value for this long.
+	// 0 disables idle eviction.
+	IdleTimeout time.Duration
+	// OnEviction is called after a group has been evicted and its Observable
+	// completed. Optional.
+	OnEviction func(key K)
+}
+
+// GroupByWithConfig is like GroupBy, but bounds the group subjects kept alive,
+// so high-cardinality keys do not leak memory. An evicted group completes its
+// inner Observable; a new value for that key creates a fresh group. The inner
+// Observables expose their key via GroupedObservable.
+func GroupByWithConfig[T any, K comparable](iteratee func(item T) K, config GroupByConfig[K]) func(Observable[T]) Observable[GroupedObservable[K, T]] {
+	if config.MaxGroups < 0 {
+		panic(ErrGroupByWithConfigWrongMaxGroups)
+	}
+
+	if config.IdleTimeout < 0 {
+		panic(ErrGroupByWithConfigWrongIdleTimeout)
+	}
+
+	type group struct {
+		subject Subject[T]
+		timer   *time.Timer
+	}
+
+	return func(source Observable[T]) Observable[GroupedObservable[K, T]] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[GroupedObservable[K, T]]) Teardown {
+			mu := sync.Mutex{}
+			groups := map[K]*group{}
+			order := []K{} // insertion order, for MaxGroups eviction
+			done := false
+
+			// removeLocked detaches a group from the bookkeeping. The caller
+			// completes the subject and runs the callback after unlocking, so
+			// user code never runs under the mutex.
+			removeLocked := func(key K) *group {
+				g, ok := groups[key]
+				if !ok {
+					return nil
+				}
+
+				delete(groups, key)
+
+				for i := range order {
+					if order[i] == key {
+						order = append(order[:i], order[i+1:]...)
+						break
+					}
+				}
+
+				if g.timer != nil {
+					g.timer.Stop()
+				}
+
+				return g
+			}
+
+			evictIdle := func(key K) {
+				mu.Lock()
+
+				if done {
+					mu.Unlock()
+					return
+				}
+
+				g := removeLocked(key)
+
+				mu.Unlock()
+
+				if g != nil {
+					g.subject.Complete()
+
+					if config.OnEviction != nil {
+						config.OnEviction(key)
+					}
+				}
+			}
+
+			finalize := func(cb func(Observer[T])) {
+				mu.Lock()
+
+				done = true
+				all := groups
+				groups = map[K]*group{}
+				order = nil
+
+				mu.Unlock()
+
+				for _, g := range all {
+					if g.timer != nil {
+						g.timer.Stop()
+					}
+
+					cb(g.subject.AsObserver())
+				}
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						key := iteratee(value)
+
+						mu.Lock()
+
+						if done {
+							mu.Unlock()
+							return
+						}
+
+						if g, ok := groups[key]; ok {
+							if g.timer != nil {
+								g.timer.Reset(config.IdleTimeout)
+							}
+
+							mu.Unlock()
+
+							g.subject.NextWithContext(ctx, value)
+
+							return
+						}
+
+						var evicted *group
+
+						var evictedKey K
+
+						if config.MaxGroups > 0 && len(order) >= config.MaxGroups {
+							evictedKey = order[0]
+							evicted = removeLocked(evictedKey)
+						}
+
+						g := &group{subject: NewUnicastSubject[T](UnicastSubjectUnlimitedBufferSize)}
+						if config.IdleTimeout > 0 {
+							g.timer = time.AfterFunc(config.IdleTimeout, func() {
+								evictIdle(key)
+							})
+						}
+
+						groups[key] = g
+						order = append(order, key)
+
+						mu.Unlock()
+
+						if evicted != nil {
+							evicted.subject.CompleteWithContext(ctx)
+
+							if config.OnEviction != nil {
+								config.OnEviction(evictedKey)
+							}
+						}
+
+						g.subject.NextWithContext(ctx, value)
+						destination.NextWithContext(ctx, &groupedObservableImpl[K, T]{key: key, subject: g.subject})
+					},
+					func(ctx context.Context, err error) {
+						destination.ErrorWithContext(ctx, err)
+						finalize(func(o Observer[T]) { o.ErrorWithContext(ctx, err) })
+					},
+					func(ctx context.Context) {
+						destination.CompleteWithContext(ctx)
+						finalize(func(o Observer[T]) { o.CompleteWithContext(ctx) })
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+				finalize(func(o Observer[T]) { o.CompleteWithContext(context.TODO()) })
+			}
+		})
+	}
+}
+
+// Partition splits the source Observable into a pair of Observables: one with
+// the items that match the predicate and one with the items that do not. The
+// source is subscribed exactly once, when both outputs have a subscriber, so
+// cold sources work and upstream side effects run once. Both outputs receive
+// the same error/complete notification, and the source is unsubscribed once
+// both outputs are torn down.
+func Partition[T any](source Observable[T], predicate func(item T) bool) (matches Observable[T], others Observable[T]) {
+	matchesSubject := NewPublishSubject[T]()
+	othersSubject := NewPublishSubject[T]()
+
+	mu := xsync.NewMutexWithSpinlock()
+	ready := [2]bool{}
+	connected := false
+	active := 0
+	upstream := NewSubscription(nil)
+
+	connect := func(ctx context.Context) {
+		mu.Lock()
+
+		if !ready[0] || !ready[1] || connected {
+			mu.Unlock()
+			return
+		}
+
+		connected = true
+		mu.Unlock()
+
+		upstream.AddUnsubscribable(
+			source.SubscribeWithContext(
+				ctx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if predicate(value) {
+							matchesSubject.NextWithContext(ctx, value)
+						} else {
+							othersSubject.NextWithContext(ctx, value)
+						}
+					},
+					func(ctx context.Context, err error) {
+						matchesSubject.ErrorWithContext(ctx, err)
+						othersSubject.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						matchesSubject.CompleteWithContext(ctx)
+						othersSubject.CompleteWithContext(ctx)
+					},
+				),
+			),
+		)
+	}
+
+	makeBranch := func(index int, subject Subject[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			sub := subject.SubscribeWithContext(subscriberCtx, destination)
+
+			mu.Lock()
+			ready[index] = true
+			active++
+			mu.Unlock()
+
+			connect(subscriberCtx)
+
+			return func() {
+				sub.Unsubscribe()
+
+				mu.Lock()
+				active--
+				shouldUnsubscribe := connected && active == 0
+				mu.Unlock()
+
+				if shouldUnsubscribe {
+					upstream.Unsubscribe()
+				}
+			}
+		})
+	}
+
+	return makeBranch(0, matchesSubject), makeBranch(1, othersSubject)
+}
+
 // BufferWhen buffers the items emitted by an Observable until a second Observable emits an item.
 // Then it emits the buffer and starts a new buffer. It repeats this process until the source Observable completes.
 // If the boundary Observable completes, the buffer is emitted and the source Observable completes.
@@ -440,10 +1183,152 @@ func BufferWhen[T, B any](boundary Observable[B]) func(Observable[T]) Observable
 						func(ctx context.Context, value B) {
 							flush(ctx)
 						},
-						destination.ErrorWithContext,
+						destination.ErrorWithContext,
+						func(ctx context.Context) {
+							flush(ctx)
+							destination.CompleteWithContext(ctx)
+						},
+					),
+				),
+			)
+
+			return func() {
+				subscriptions.Unsubscribe()
+				mu.Lock()
+
+				buffer = []T{}
+
+				mu.Unlock()
+			}
+		})
+	}
+}
+
+// BufferToggle buffers the items emitted by an Observable between an opening and a closing
+// notifier. A new buffer opens every time the openings Observable emits an item, and closes
+// when the Observable returned by the closing selector for that item emits its first value
+// or completes. Buffers may overlap, each one closing independently. If the source Observable
+// completes, all open buffers are emitted and the complete notification is propagated. If the
+// source Observable errors, all open buffers are discarded and the error is propagated.
+func BufferToggle[T, O, C any](openings Observable[O], closing func(opening O) Observable[C]) func(Observable[T]) Observable[[]T] {
+	return func(source Observable[T]) Observable[[]T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
+			type openBuffer struct {
+				id     int64
+				values []T
+			}
+
+			mu := xsync.NewMutexWithSpinlock()
+			buffers := []*openBuffer{}
+			nextID := int64(0)
+
+			closeBuffer := func(ctx context.Context, id int64) {
+				mu.Lock()
+
+				var closed *openBuffer
+
+				for i, b := range buffers {
+					if b.id == id {
+						closed = b
+						buffers = append(buffers[:i], buffers[i+1:]...)
+
+						break
+					}
+				}
+
+				mu.Unlock()
+
+				if closed != nil {
+					destination.NextWithContext(ctx, closed.values)
+				}
+			}
+
+			flushAll := func(ctx context.Context) {
+				mu.Lock()
+
+				tmp := buffers
+				buffers = []*openBuffer{}
+
+				mu.Unlock()
+
+				for _, b := range tmp {
+					destination.NextWithContext(ctx, b.values)
+				}
+			}
+
+			discardAll := func() {
+				mu.Lock()
+
+				buffers = []*openBuffer{}
+
+				mu.Unlock()
+			}
+
+			subscriptions := NewSubscription(nil)
+
+			subscriptions.AddUnsubscribable(
+				source.SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, value T) {
+							mu.Lock()
+
+							for _, b := range buffers {
+								b.values = append(b.values, value)
+							}
+
+							mu.Unlock()
+						},
+						func(ctx context.Context, err error) {
+							discardAll()
+							destination.ErrorWithContext(ctx, err)
+						},
+						func(ctx context.Context) {
+							flushAll(ctx)
+							destination.CompleteWithContext(ctx)
+						},
+					),
+				),
+			)
+
+			subscriptions.AddUnsubscribable(
+				openings.SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, value O) {
+							mu.Lock()
+
+							id := nextID
+							nextID++
+							buffers = append(buffers, &openBuffer{id: id, values: []T{}})
+
+							mu.Unlock()
+
+							subscriptions.AddUnsubscribable(
+								closing(value).SubscribeWithContext(
+									subscriberCtx,
+									NewObserverWithContext(
+										func(ctx context.Context, _ C) {
+											// closeBuffer is a noop when the buffer has already been closed
+											closeBuffer(ctx, id)
+										},
+										func(ctx context.Context, err error) {
+											discardAll()
+											destination.ErrorWithContext(ctx, err)
+										},
+										func(ctx context.Context) {
+											closeBuffer(ctx, id)
+										},
+									),
+								),
+							)
+						},
+						func(ctx context.Context, err error) {
+							discardAll()
+							destination.ErrorWithContext(ctx, err)
+						},
 						func(ctx context.Context) {
-							flush(ctx)
-							destination.CompleteWithContext(ctx)
+							// no new buffers will open, but already open buffers keep collecting
 						},
 					),
 				),
@@ -451,11 +1336,7 @@ func BufferWhen[T, B any](boundary Observable[B]) func(Observable[T]) Observable
 
 			return func() {
 				subscriptions.Unsubscribe()
-				mu.Lock()
-
-				buffer = []T{}
-
-				mu.Unlock()
+				discardAll()
 			}
 		})
 	}
@@ -548,6 +1429,148 @@ func BufferWithTimeOrCount[T any](size int, duration time.Duration) func(Observa
 	}
 }
 
+// BatchByKey buffers the items emitted by an Observable into per-key batches,
+// using the key function to route each item. A batch is flushed as a
+// lo.Tuple2 of key and items when it reaches the given size, or when the
+// interval has elapsed since its first buffered item. All pending batches are
+// flushed on completion, in the order their first item arrived. This supports
+// "bulk-insert per table/shard" patterns that a single global buffer like
+// BufferWithTimeOrCount cannot express. If the source errors, pending batches
+// are dropped and the error is propagated.
+func BatchByKey[T any, K comparable](key func(item T) K, size int, interval time.Duration) func(Observable[T]) Observable[lo.Tuple2[K, []T]] {
+	if size < 1 {
+		panic(ErrBatchByKeyWrongSize)
+	}
+
+	if interval <= 0 {
+		panic(ErrBatchByKeyWrongInterval)
+	}
+
+	return func(source Observable[T]) Observable[lo.Tuple2[K, []T]] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[lo.Tuple2[K, []T]]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+			buffers := map[K][]T{}
+			timers := map[K]*time.Timer{}
+			order := []K{}
+			done := false
+
+			removeKey := func(k K) {
+				delete(buffers, k)
+
+				if timer, ok := timers[k]; ok {
+					timer.Stop()
+					delete(timers, k)
+				}
+
+				for i := range order {
+					if order[i] == k {
+						order = append(order[:i], order[i+1:]...)
+						break
+					}
+				}
+			}
+
+			flush := func(ctx context.Context, k K) {
+				mu.Lock()
+
+				batch, ok := buffers[k]
+				if done || !ok {
+					mu.Unlock()
+					return
+				}
+
+				removeKey(k)
+
+				mu.Unlock()
+
+				destination.NextWithContext(ctx, lo.T2(k, batch))
+			}
+
+			stop := func() {
+				done = true
+
+				for _, timer := range timers {
+					timer.Stop()
+				}
+
+				buffers = map[K][]T{}
+				timers = map[K]*time.Timer{}
+				order = []K{}
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						k := key(value)
+
+						mu.Lock()
+
+						if done {
+							mu.Unlock()
+							return
+						}
+
+						buffers[k] = append(buffers[k], value)
+
+						if len(buffers[k]) == 1 {
+							order = append(order, k)
+							timers[k] = time.AfterFunc(interval, func() {
+								flush(ctx, k)
+							})
+						}
+
+						isFull := len(buffers[k]) >= size
+
+						mu.Unlock()
+
+						if isFull {
+							flush(ctx, k)
+						}
+					},
+					func(ctx context.Context, err error) {
+						mu.Lock()
+
+						stop()
+
+						mu.Unlock()
+
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+
+						pending := make([]lo.Tuple2[K, []T], 0, len(order))
+						for _, k := range order {
+							pending = append(pending, lo.T2(k, buffers[k]))
+						}
+
+						stop()
+
+						mu.Unlock()
+
+						for _, batch := range pending {
+							destination.NextWithContext(ctx, batch)
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				mu.Lock()
+
+				stop()
+
+				mu.Unlock()
+			}
+		})
+	}
+}
+
 // BufferWithCount buffers the items emitted by an Observable until the buffer is full.
 // Then it emits the buffer and starts a new buffer. It repeats this process until the
 // source Observable completes. If the source Observable errors, the buffer is emitted
@@ -588,26 +1611,320 @@ func BufferWithCount[T any](size int) func(Observable[T]) Observable[[]T] {
 			return func() {
 				sub.Unsubscribe()
 
-				buffer = []T{}
+				buffer = []T{}
+			}
+		})
+	}
+}
+
+// BufferWithCountAndSkip buffers the items emitted by an Observable, opening a
+// new buffer every `startEvery` items and emitting each buffer once it holds
+// `size` items. With startEvery < size the buffers overlap (sliding window),
+// with startEvery > size the items in between are skipped, and with
+// startEvery == size it behaves like BufferWithCount. Partial buffers are
+// flushed on completion. If the source Observable errors, the error is
+// propagated immediately and pending buffers are discarded. An invalid size
+// or startEvery produces an Observable erroring on subscription, instead of
+// panicking.
+func BufferWithCountAndSkip[T any](size int, startEvery int) func(Observable[T]) Observable[[]T] {
+	if size < 1 {
+		return func(Observable[T]) Observable[[]T] {
+			return Throw[[]T](ErrBufferWithCountAndSkipWrongSize)
+		}
+	}
+
+	if startEvery < 1 {
+		return func(Observable[T]) Observable[[]T] {
+			return Throw[[]T](ErrBufferWithCountAndSkipWrongStartEvery)
+		}
+	}
+
+	return func(source Observable[T]) Observable[[]T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
+			buffers := [][]T{}
+			count := 0
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if count%startEvery == 0 {
+							buffers = append(buffers, make([]T, 0, size))
+						}
+
+						count++
+
+						for i := range buffers {
+							buffers[i] = append(buffers[i], value)
+						}
+
+						if len(buffers) > 0 && len(buffers[0]) >= size {
+							destination.NextWithContext(ctx, buffers[0])
+							buffers = buffers[1:]
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						for _, buffer := range buffers {
+							if len(buffer) > 0 {
+								destination.NextWithContext(ctx, buffer)
+							}
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				buffers = nil
+			}
+		})
+	}
+}
+
+// BufferWithTime buffers the items emitted by an Observable for a specified time.
+// It emits the buffer and starts a new buffer. It repeats this process until the source
+// Observable completes. If the source Observable errors, the buffer is emitted and the error
+// is propagated. If the source Observable completes, the buffer is emitted and the complete
+// notification is propagated. If the specified time is reached, the buffer is emitted and a new buffer is started.
+// Play: https://go.dev/play/p/TfOhP-f_O45
+func BufferWithTime[T any](duration time.Duration) func(Observable[T]) Observable[[]T] {
+	if duration <= 0 {
+		panic(ErrBufferWithTimeWrongDuration)
+	}
+
+	return BufferWhen[T](Interval(duration))
+}
+
+// HoppingWindow emits a buffer of the values observed during the last
+// `windowSize`, every `hop` interval. Windows overlap when hop is smaller
+// than windowSize, which supports smoothed rolling aggregations such as a
+// 5-minute average updated every minute. BufferWithTime is the tumbling
+// (non-overlapping) equivalent. On completion, the current window is flushed.
+// It panics if windowSize or hop is lower than or equal to 0.
+func HoppingWindow[T any](windowSize time.Duration, hop time.Duration) func(Observable[T]) Observable[[]T] {
+	if windowSize <= 0 {
+		panic(ErrHoppingWindowWrongWindowSize)
+	}
+
+	if hop <= 0 {
+		panic(ErrHoppingWindowWrongHop)
+	}
+
+	return func(source Observable[T]) Observable[[]T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+
+			var samples []lo.Tuple2[time.Time, T]
+
+			done := false
+
+			snapshot := func(now time.Time) []T {
+				deadline := now.Add(-windowSize)
+
+				i := 0
+				for i < len(samples) && samples[i].A.Before(deadline) {
+					i++
+				}
+
+				samples = samples[i:]
+
+				window := make([]T, 0, len(samples))
+				for _, sample := range samples {
+					window = append(window, sample.B)
+				}
+
+				return window
+			}
+
+			var timer *time.Timer
+
+			// the assignment is guarded so that the callback, which re-arms
+			// the timer, cannot observe it before it is set
+			mu.Lock()
+
+			timer = time.AfterFunc(hop, func() {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if done {
+					return
+				}
+
+				destination.NextWithContext(subscriberCtx, snapshot(time.Now()))
+
+				timer.Reset(hop)
+			})
+
+			mu.Unlock()
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						if done {
+							return
+						}
+
+						samples = append(samples, lo.T2(time.Now(), value))
+					},
+					func(ctx context.Context, err error) {
+						mu.Lock()
+
+						done = true
+						samples = nil
+						timer.Stop()
+
+						// the terminal notification runs the teardown, which
+						// takes the lock again
+						mu.Unlock()
+
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+
+						done = true
+						window := snapshot(time.Now())
+						samples = nil
+						timer.Stop()
+
+						mu.Unlock()
+
+						destination.NextWithContext(ctx, window)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				mu.Lock()
+
+				done = true
+				samples = nil
+				timer.Stop()
+
+				mu.Unlock()
+			}
+		})
+	}
+}
+
+// TumblingBillingWindow groups values into consecutive windows of the given
+// duration, aligned to the calendar boundary computed by the boundary function
+// (e.g. time.Time.Truncate for hourly alignment). When a window closes, its
+// start time and values are emitted as a pair, so per-hour/per-day metering
+// aggregations stay aligned with billing periods. Empty windows are emitted
+// too. On completion, the current partial window is flushed.
+// It panics if window is lower than or equal to 0 or if boundary is nil.
+func TumblingBillingWindow[T any](window time.Duration, boundary func(t time.Time) time.Time) func(Observable[T]) Observable[lo.Tuple2[time.Time, []T]] {
+	if window <= 0 {
+		panic(ErrTumblingBillingWindowWrongWindow)
+	}
+
+	if boundary == nil {
+		panic(ErrTumblingBillingWindowMissingBoundary)
+	}
+
+	return func(source Observable[T]) Observable[lo.Tuple2[time.Time, []T]] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[lo.Tuple2[time.Time, []T]]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+
+			windowStart := boundary(time.Now())
+			values := []T{}
+
+			done := false
+
+			var timer *time.Timer
+
+			// the assignment is guarded so that the callback, which re-arms
+			// the timer, cannot observe it before it is set
+			mu.Lock()
+
+			timer = time.AfterFunc(time.Until(windowStart.Add(window)), func() {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if done {
+					return
+				}
+
+				destination.NextWithContext(subscriberCtx, lo.T2(windowStart, values))
+
+				windowStart = boundary(time.Now())
+				values = []T{}
+
+				timer.Reset(time.Until(windowStart.Add(window)))
+			})
+
+			mu.Unlock()
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						if done {
+							return
+						}
+
+						values = append(values, value)
+					},
+					func(ctx context.Context, err error) {
+						mu.Lock()
+
+						done = true
+						values = nil
+						timer.Stop()
+
+						// the terminal notification runs the teardown, which
+						// takes the lock again
+						mu.Unlock()
+
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+
+						done = true
+						start := windowStart
+						window := values
+						values = nil
+						timer.Stop()
+
+						mu.Unlock()
+
+						destination.NextWithContext(ctx, lo.T2(start, window))
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				mu.Lock()
+
+				done = true
+				values = nil
+				timer.Stop()
+
+				mu.Unlock()
 			}
 		})
 	}
 }
 
-// BufferWithTime buffers the items emitted by an Observable for a specified time.
-// It emits the buffer and starts a new buffer. It repeats this process until the source
-// Observable completes. If the source Observable errors, the buffer is emitted and the error
-// is propagated. If the source Observable completes, the buffer is emitted and the complete
-// notification is propagated. If the specified time is reached, the buffer is emitted and a new buffer is started.
-// Play: https://go.dev/play/p/TfOhP-f_O45
-func BufferWithTime[T any](duration time.Duration) func(Observable[T]) Observable[[]T] {
-	if duration <= 0 {
-		panic(ErrBufferWithTimeWrongDuration)
-	}
-
-	return BufferWhen[T](Interval(duration))
-}
-
 // WindowWhen emits an Observable that represents a window of items emitted by the source Observable.
 // The window emits items when the specified boundary Observable emits an item. The window closes
 // and a new window opens when the boundary Observable emits an item. If the source Observable completes,
@@ -697,6 +2014,80 @@ func WindowWhen[T, B any](boundary Observable[B]) func(Observable[T]) Observable
 	}
 }
 
+// WindowWithCount emits an Observable that represents a window of items emitted by the
+// source Observable. The window closes and a new window opens every `size` items, like
+// BufferWithCount but streaming the items instead of materializing slices. The last
+// partial window completes when the source Observable completes. If the source Observable
+// errors, the current window completes and the error is propagated.
+func WindowWithCount[T any](size int) func(Observable[T]) Observable[Observable[T]] {
+	if size < 1 {
+		panic(ErrWindowWithCountWrongSize)
+	}
+
+	return func(source Observable[T]) Observable[Observable[T]] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[Observable[T]]) Teardown {
+			var window Subject[T]
+
+			count := 0
+
+			closeWindow := func(ctx context.Context) {
+				if window != nil {
+					window.CompleteWithContext(ctx)
+					window = nil
+				}
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if window == nil {
+							window = NewUnicastSubject[T](UnicastSubjectUnlimitedBufferSize)
+							count = 0
+
+							destination.NextWithContext(ctx, window)
+						}
+
+						window.NextWithContext(ctx, value)
+						count++
+
+						if count >= size {
+							closeWindow(ctx)
+						}
+					},
+					func(ctx context.Context, err error) {
+						closeWindow(ctx)
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						closeWindow(ctx)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+				closeWindow(context.TODO())
+			}
+		})
+	}
+}
+
+// WindowWithTime emits an Observable that represents a window of items emitted by the
+// source Observable. The window closes and a new window opens every `duration`, like
+// BufferWithTime but streaming the items instead of materializing slices. If the source
+// Observable completes, the current window completes and the complete notification is
+// propagated. If the source Observable errors, the current window completes and the
+// error is propagated.
+func WindowWithTime[T any](duration time.Duration) func(Observable[T]) Observable[Observable[T]] {
+	if duration <= 0 {
+		panic(ErrWindowWithTimeWrongDuration)
+	}
+
+	return WindowWhen[T](Interval(duration))
+}
+
 // SampleWhen emits the most recently emitted value from the source Observable
 // within a period determined by another Observable?
 //
@@ -774,6 +2165,241 @@ func SampleTime[T any](interval time.Duration) func(Observable[T]) Observable[T]
 	)
 }
 
+// SampleOrLatest emits every value from the source Observable immediately and,
+// when no value has arrived within the given period, re-emits the latest value
+// as a keepalive tick. This hybrid of passthrough and periodic refresh is
+// useful for driving downstream systems that need both real updates and
+// periodic confirmation of unchanged state. No keepalive is emitted before the
+// first value. It panics if period is lower or equal to 0.
+func SampleOrLatest[T any](period time.Duration) func(Observable[T]) Observable[T] {
+	if period <= 0 {
+		panic(ErrSampleOrLatestWrongPeriod)
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+
+			var latest lo.Tuple2[context.Context, T]
+
+			hasLatest := false
+			done := false
+
+			var timer *time.Timer
+
+			// the assignment is guarded so that the callback, which re-arms
+			// the timer, cannot observe it before it is set
+			mu.Lock()
+
+			timer = time.AfterFunc(period, func() {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if done {
+					return
+				}
+
+				if hasLatest {
+					destination.NextWithContext(latest.A, latest.B)
+				}
+
+				timer.Reset(period)
+			})
+
+			mu.Unlock()
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						if done {
+							return
+						}
+
+						latest = lo.T2(ctx, value)
+						hasLatest = true
+						timer.Reset(period)
+
+						destination.NextWithContext(ctx, value)
+					},
+					func(ctx context.Context, err error) {
+						mu.Lock()
+
+						done = true
+						timer.Stop()
+
+						// the terminal notification runs the teardown, which
+						// takes the lock again
+						mu.Unlock()
+
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+
+						done = true
+						timer.Stop()
+
+						mu.Unlock()
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				mu.Lock()
+
+				done = true
+				timer.Stop()
+
+				mu.Unlock()
+			}
+		})
+	}
+}
+
+// InterpolationMode determines how Resample computes values between input samples.
+type InterpolationMode uint8
+
+// InterpolationMode constants.
+const (
+	// InterpolationHold repeats the most recent input sample (sample-and-hold).
+	InterpolationHold InterpolationMode = iota
+	// InterpolationLinear interpolates linearly between the surrounding input samples.
+	InterpolationLinear
+)
+
+// Resample emits a value every `period`, converting an irregularly spaced input
+// stream into an evenly spaced output stream. This is useful for aligning sensor
+// streams to a fixed rate before combining them.
+//
+// With InterpolationHold, the most recent input sample is repeated on every tick,
+// starting after the first sample arrives. With InterpolationLinear, each tick value
+// is interpolated between the surrounding input samples: a tick can only be emitted
+// once the input sample following it has arrived, so the output lags the input by
+// one sample.
+func Resample[T constraints.Numeric](period time.Duration, interpolate InterpolationMode) func(Observable[T]) Observable[float64] {
+	if period <= 0 {
+		panic(ErrResampleWrongPeriod)
+	}
+
+	switch interpolate {
+	case InterpolationHold:
+		return resampleHold[T](period)
+	case InterpolationLinear:
+		return resampleLinear[T](period)
+	}
+
+	panic(ErrResampleWrongInterpolationMode)
+}
+
+func resampleHold[T constraints.Numeric](period time.Duration) func(Observable[T]) Observable[float64] {
+	return func(source Observable[T]) Observable[float64] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			var last float64
+
+			has := false
+			mu := xsync.NewMutexWithSpinlock()
+
+			subscriptions := NewSubscription(nil)
+
+			subscriptions.AddUnsubscribable(
+				source.SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, value T) {
+							mu.Lock()
+
+							last = float64(value)
+							has = true
+
+							mu.Unlock()
+						},
+						destination.ErrorWithContext,
+						destination.CompleteWithContext,
+					),
+				),
+			)
+
+			subscriptions.AddUnsubscribable(
+				Interval(period).SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, _ int64) {
+							mu.Lock()
+
+							value, ok := last, has
+
+							mu.Unlock()
+
+							if ok {
+								destination.NextWithContext(ctx, value)
+							}
+						},
+						destination.ErrorWithContext,
+						destination.CompleteWithContext,
+					),
+				),
+			)
+
+			return subscriptions.Unsubscribe
+		})
+	}
+}
+
+func resampleLinear[T constraints.Numeric](period time.Duration) func(Observable[T]) Observable[float64] {
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			start := time.Now()
+			nextTick := period
+
+			var prevValue float64
+
+			var prevAt time.Duration
+
+			has := false
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						now := time.Since(start)
+						cur := float64(value)
+
+						if has {
+							for nextTick <= now {
+								fraction := float64(nextTick-prevAt) / float64(now-prevAt)
+								destination.NextWithContext(ctx, prevValue+fraction*(cur-prevValue))
+
+								nextTick += period
+							}
+						} else {
+							// ticks before the first sample cannot be interpolated
+							for nextTick <= now {
+								nextTick += period
+							}
+						}
+
+						prevValue = cur
+						prevAt = now
+						has = true
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // ThrottleWhen emits a value from the source Observable, then ignores subsequent source
 // values for a duration determined by another Observable, then repeats this process.
 // Play: https://go.dev/play/p/q3ISV03EL3q
@@ -853,3 +2479,112 @@ func ThrottleTime[T any](interval time.Duration) func(Observable[T]) Observable[
 		})
 	}
 }
+
+// RateLimit caps the throughput of the source Observable using a token bucket
+// refilled at `rate` tokens per second with a capacity of `burst` tokens. Each
+// emission consumes one token; when the bucket is empty, the emission is delayed
+// until a token is available, blocking the producer. Unlike ThrottleTime, which
+// drops the values arriving during the silence interval, RateLimit never drops
+// values: it only paces them. The wait is aborted on unsubscription.
+// Error and Complete notifications are forwarded without delay.
+func RateLimit[T any](rate float64, burst int) func(Observable[T]) Observable[T] {
+	if rate <= 0 {
+		panic(ErrRateLimitWrongRate)
+	}
+
+	if burst < 1 {
+		panic(ErrRateLimitWrongBurst)
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+			done := make(chan struct{})
+
+			tokens := float64(burst)
+			lastAt := xtime.NowNanoMonotonic()
+
+			// take consumes a token, returning how long the caller must wait
+			// for the token to be available.
+			take := func() time.Duration {
+				mu.Lock()
+				defer mu.Unlock()
+
+				now := xtime.NowNanoMonotonic()
+
+				tokens += float64(now-lastAt) / float64(time.Second) * rate
+				if tokens > float64(burst) {
+					tokens = float64(burst)
+				}
+
+				lastAt = now
+				tokens--
+
+				if tokens >= 0 {
+					return 0
+				}
+
+				return time.Duration(-tokens / rate * float64(time.Second))
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						delay := take()
+
+						if delay > 0 {
+							timer := time.NewTimer(delay)
+							defer timer.Stop()
+
+							select {
+							case <-timer.C:
+							case <-done:
+								return
+							}
+						}
+
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return func() {
+				close(done)
+				sub.Unsubscribe()
+			}
+		})
+	}
+}
+
+// Intersperse inserts a separator between consecutive elements of the source
+// Observable. No separator is emitted before the first element or after the
+// last one.
+func Intersperse[T any](separator T) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			first := true
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if !first {
+							destination.NextWithContext(ctx, separator)
+						}
+
+						first = false
+
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
@@ -17,12 +17,33 @@ package ro
 import (
 	"io/fs"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestOperatorTransformationMapSame(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		MapSame(Just(1, 2, 3), func(v int) int { return v * 2 }),
+	)
+	is.Equal([]int{2, 4, 6}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MapSame(Throw[int](assert.AnError), func(v int) int { return v }),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorTransformationMap(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -101,6 +122,26 @@ func TestOperatorTransformationMapI(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorTransformationMapWithIndex(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	obs := MapWithIndex(func(v, i int) int {
+		is.Equal(i, v)
+		return v * 2
+	})(Just(0, 1, 2, 3))
+
+	values, err := Collect(obs)
+	is.Equal([]int{0, 2, 4, 6}, values)
+	is.NoError(err)
+
+	// index resets on resubscription
+	values, err = Collect(obs)
+	is.Equal([]int{0, 2, 4, 6}, values)
+	is.NoError(err)
+}
+
 func TestOperatorTransformationMapTo(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -179,6 +220,182 @@ func TestOperatorTransformationMapErrI(t *testing.T) { //nolint:paralleltest
 	// @TODO: Implement tests
 }
 
+func TestOperatorTransformationMapConcurrent(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 5*time.Second)
+	is := assert.New(t)
+
+	// results are emitted in input order, even when later items finish first
+	values, err := Collect(
+		Pipe1(
+			Just(50, 30, 10, 40, 20),
+			MapConcurrent(func(i int) (int, error) {
+				time.Sleep(time.Duration(i) * time.Millisecond)
+				return i * 2, nil
+			}, 5),
+		),
+	)
+	is.Equal([]int{100, 60, 20, 80, 40}, values)
+	is.NoError(err)
+
+	// concurrency is capped
+	inFlight := int64(0)
+	maxInFlight := int64(0)
+
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3, 4, 5, 6, 7, 8),
+			MapConcurrent(func(i int) (int, error) {
+				current := atomic.AddInt64(&inFlight, 1)
+				for {
+					max := atomic.LoadInt64(&maxInFlight)
+					if current <= max || atomic.CompareAndSwapInt64(&maxInFlight, max, current) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt64(&inFlight, -1)
+
+				return i, nil
+			}, 2),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 4, 5, 6, 7, 8}, values)
+	is.NoError(err)
+	is.LessOrEqual(atomic.LoadInt64(&maxInFlight), int64(2))
+
+	// a worker error propagates and drops outstanding results
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			MapConcurrent(func(i int) (int, error) {
+				if i == 1 {
+					time.Sleep(20 * time.Millisecond)
+					return 0, assert.AnError
+				}
+
+				return i * 2, nil
+			}, 3),
+		),
+	)
+	is.Empty(values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		Pipe1(
+			Empty[int](),
+			MapConcurrent(func(i int) (int, error) { return i, nil }, 2),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			MapConcurrent(func(i int) (int, error) { return i, nil }, 2),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError("ro.MapConcurrent: concurrency must be greater than 0", func() {
+		MapConcurrent(func(i int) (int, error) { return i, nil }, 0)(Just(1))
+	})
+}
+
+func TestOperatorTransformationMapConcurrentUnordered(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 5*time.Second)
+	is := assert.New(t)
+
+	// results are emitted in completion order
+	values, err := Collect(
+		Pipe1(
+			Just(30, 20, 10),
+			MapConcurrentUnordered(func(i int) (int, error) {
+				time.Sleep(time.Duration(i) * time.Millisecond)
+				return i * 2, nil
+			}, 3),
+		),
+	)
+	is.Equal([]int{20, 40, 60}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			MapConcurrentUnordered(func(i int) (int, error) {
+				if i == 1 {
+					time.Sleep(20 * time.Millisecond)
+					return 0, assert.AnError
+				}
+
+				return i * 2, nil
+			}, 3),
+		),
+	)
+	is.ElementsMatch([]int{4, 6}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		Pipe1(
+			Empty[int](),
+			MapConcurrentUnordered(func(i int) (int, error) { return i, nil }, 2),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	is.PanicsWithError("ro.MapConcurrentUnordered: concurrency must be greater than 0", func() {
+		MapConcurrentUnordered(func(i int) (int, error) { return i, nil }, 0)(Just(1))
+	})
+}
+
+func TestOperatorTransformationFlatMapErr(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			FlatMapErr(func(item int) (Observable[int], error) {
+				return Just(item, item), nil
+			}),
+		),
+	)
+	is.Equal([]int{1, 1, 2, 2, 3, 3}, values)
+	is.NoError(err)
+
+	// a projection error is converted into an Error notification
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			FlatMapErr(func(item int) (Observable[int], error) {
+				if item == 2 {
+					return nil, assert.AnError
+				}
+
+				return Just(item, item), nil
+			}),
+		),
+	)
+	is.Equal([]int{1, 1}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			FlatMapErr(func(item int) (Observable[int], error) {
+				return Just(item), nil
+			}),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorTransformationFlatMap(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -254,6 +471,88 @@ func TestOperatorTransformationFlatten(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorTransformationFlattenMap(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Just(map[string]int{"a": 1, "b": 2}, map[string]int{"c": 3}),
+			FlattenMap[string, int](),
+		),
+	)
+	// iteration order within a map is non-deterministic
+	is.ElementsMatch([]lo.Tuple2[string, int]{lo.T2("a", 1), lo.T2("b", 2), lo.T2("c", 3)}, values)
+	is.NoError(err)
+	is.Equal(lo.T2("c", 3), values[2])
+
+	values, err = Collect(
+		Pipe1(
+			Empty[map[string]int](),
+			FlattenMap[string, int](),
+		),
+	)
+	is.Equal([]lo.Tuple2[string, int]{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[map[string]int](assert.AnError),
+			FlattenMap[string, int](),
+		),
+	)
+	is.Equal([]lo.Tuple2[string, int]{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorTransformationFlattenChannel(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	newChannel := func(items ...int) chan int {
+		ch := make(chan int, len(items))
+		for _, item := range items {
+			ch <- item
+		}
+
+		close(ch)
+
+		return ch
+	}
+
+	ch1 := newChannel(1, 2, 3)
+	ch2 := newChannel(4, 5, 6)
+
+	values, err := Collect(
+		Pipe1(
+			Just(ch1, ch2),
+			FlattenChannel[int](),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 4, 5, 6}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Empty[chan int](),
+			FlattenChannel[int](),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[chan int](assert.AnError),
+			FlattenChannel[int](),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorTransformationCast(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -316,6 +615,104 @@ func TestOperatorTransformationScan(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorTransformationScanErr(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	reduce := func(acc, item int) (int, error) {
+		if item < 0 {
+			return 0, assert.AnError
+		}
+
+		return acc + item, nil
+	}
+
+	values, err := Collect(
+		ScanErr(reduce, 10)(Just(1, 2, 3)),
+	)
+	is.Equal([]int{11, 13, 16}, values)
+	is.NoError(err)
+
+	// the stream terminates on the first reducer failure
+	values, err = Collect(
+		ScanErr(reduce, 10)(Just(1, -1, 3)),
+	)
+	is.Equal([]int{11}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		ScanErr(reduce, 10)(Empty[int]()),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ScanErr(reduce, 10)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorTransformationScanWithSeedEmit(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	reduce := func(acc, item int) int { return acc + item }
+
+	values, err := Collect(
+		ScanWithSeedEmit(reduce, 10)(Just(1, 2, 3)),
+	)
+	is.Equal([]int{10, 11, 13, 16}, values)
+	is.NoError(err)
+
+	// an empty source still emits the seed
+	values, err = Collect(
+		ScanWithSeedEmit(reduce, 10)(Empty[int]()),
+	)
+	is.Equal([]int{10}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ScanWithSeedEmit(reduce, 10)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{10}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorTransformationScanWith(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	reduce := func(acc, item int) int { return acc + item }
+
+	values, err := Collect(
+		ScanWith(reduce)(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]int{1, 3, 6, 10}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ScanWith(reduce)(Just(42)),
+	)
+	is.Equal([]int{42}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ScanWith(reduce)(Empty[int]()),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ScanWith(reduce)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorTransformationScanI(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -389,6 +786,127 @@ func TestOperatorTransformationGroupBy(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorTransformationGroupByWithConfig(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 200*time.Millisecond)
+	is := assert.New(t)
+
+	key := func(s string) string { return s[:1] }
+
+	mu := lo.Synchronize()
+	evicted := []string{}
+	keys := []string{}
+	contents := map[string][]string{}
+
+	inner := []Subscription{}
+
+	sub := Pipe1(
+		Just("a1", "b1", "c1", "a2"),
+		GroupByWithConfig(key, GroupByConfig[string]{
+			MaxGroups: 2,
+			OnEviction: func(k string) {
+				mu.Do(func() { evicted = append(evicted, k) })
+			},
+		}),
+	).Subscribe(OnNext(func(g GroupedObservable[string, string]) {
+		k := g.Key()
+
+		mu.Do(func() { keys = append(keys, k) })
+
+		s := g.Subscribe(OnNext(func(v string) {
+			mu.Do(func() { contents[k] = append(contents[k], v) })
+		}))
+
+		mu.Do(func() { inner = append(inner, s) })
+	}))
+
+	sub.Wait()
+
+	for _, s := range inner {
+		s.Wait()
+	}
+
+	// "c1" evicts the oldest group "a"; "a2" then evicts "b" and opens a
+	// fresh "a" group.
+	is.Equal([]string{"a", "b", "c", "a"}, keys)
+	is.Equal([]string{"a", "b"}, evicted)
+	is.Equal(map[string][]string{"a": {"a1", "a2"}, "b": {"b1"}, "c": {"c1"}}, contents)
+
+	values, err := Collect(
+		Pipe2(
+			Throw[string](assert.AnError),
+			GroupByWithConfig(key, GroupByConfig[string]{}),
+			Map(func(g GroupedObservable[string, string]) string { return g.Key() }),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrGroupByWithConfigWrongMaxGroups.Error(), func() {
+		GroupByWithConfig(key, GroupByConfig[string]{MaxGroups: -1})
+	})
+	is.PanicsWithError(ErrGroupByWithConfigWrongIdleTimeout.Error(), func() {
+		GroupByWithConfig(key, GroupByConfig[string]{IdleTimeout: -1})
+	})
+}
+
+func TestOperatorTransformationGroupByWithConfigIdleTimeout(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	subject := NewPublishSubject[string]()
+
+	mu := lo.Synchronize()
+	keys := []string{}
+	completed := []string{}
+	evictedCh := make(chan string, 4)
+
+	inner := []Subscription{}
+
+	sub := Pipe1(
+		subject.AsObservable(),
+		GroupByWithConfig(func(s string) string { return s }, GroupByConfig[string]{
+			IdleTimeout: 50 * time.Millisecond,
+			OnEviction:  func(k string) { evictedCh <- k },
+		}),
+	).Subscribe(OnNext(func(g GroupedObservable[string, string]) {
+		k := g.Key()
+
+		mu.Do(func() { keys = append(keys, k) })
+
+		s := g.Subscribe(NewObserver(
+			func(v string) {},
+			func(err error) {},
+			func() {
+				mu.Do(func() { completed = append(completed, k) })
+			},
+		))
+
+		mu.Do(func() { inner = append(inner, s) })
+	}))
+
+	subject.Next("a")
+
+	// the idle timer evicts "a" and completes its group
+	is.Equal("a", <-evictedCh)
+
+	// a new value for an evicted key opens a fresh group
+	subject.Next("a")
+	subject.Complete()
+
+	sub.Wait()
+
+	for _, s := range inner {
+		s.Wait()
+	}
+
+	mu.Do(func() {
+		is.Equal([]string{"a", "a"}, keys)
+		is.Equal([]string{"a", "a"}, completed)
+	})
+}
+
 func TestOperatorTransformationBufferWhen(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 1000*time.Millisecond)
@@ -436,8 +954,260 @@ func TestOperatorTransformationBufferWhen(t *testing.T) { //nolint:paralleltest
 			BufferWhen[int64](Throw[int64](assert.AnError)),
 		),
 	)
-	is.Equal([][]int64{}, values)
+	is.Equal([][]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorTransformationPartition(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	subscriptions := 0
+	source := NewPublishSubject[int]()
+
+	matchesObservable, othersObservable := Partition(
+		DoOnSubscribe[int](func() {
+			subscriptions++
+		})(source.AsObservable()),
+		func(item int) bool {
+			return item%2 == 0
+		},
+	)
+
+	evens := []int{}
+	odds := []int{}
+	evensCompleted := false
+	oddsCompleted := false
+
+	sub1 := matchesObservable.Subscribe(NewObserver(
+		func(value int) { evens = append(evens, value) },
+		func(err error) {},
+		func() { evensCompleted = true },
+	))
+	sub2 := othersObservable.Subscribe(NewObserver(
+		func(value int) { odds = append(odds, value) },
+		func(err error) {},
+		func() { oddsCompleted = true },
+	))
+
+	source.Next(1)
+	source.Next(2)
+	source.Next(3)
+	source.Next(4)
+	source.Complete()
+
+	is.Equal([]int{2, 4}, evens)
+	is.Equal([]int{1, 3}, odds)
+	is.True(evensCompleted)
+	is.True(oddsCompleted)
+	is.Equal(1, subscriptions)
+
+	sub1.Unsubscribe()
+	sub2.Unsubscribe()
+}
+
+func TestOperatorTransformationPartitionColdSource(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	subscriptions := 0
+
+	matchesObservable, othersObservable := Partition(
+		DoOnSubscribe[int64](func() {
+			subscriptions++
+		})(Range(1, 6)),
+		func(item int64) bool {
+			return item%2 == 0
+		},
+	)
+
+	evens := []int64{}
+	odds := []int64{}
+
+	// the cold source is not subscribed until both outputs are
+	sub1 := matchesObservable.Subscribe(OnNext(func(value int64) {
+		evens = append(evens, value)
+	}))
+	is.Equal(0, subscriptions)
+
+	sub2 := othersObservable.Subscribe(OnNext(func(value int64) {
+		odds = append(odds, value)
+	}))
+
+	is.Equal(1, subscriptions)
+	is.Equal([]int64{2, 4}, evens)
+	is.Equal([]int64{1, 3, 5}, odds)
+
+	sub1.Unsubscribe()
+	sub2.Unsubscribe()
+}
+
+func TestOperatorTransformationPartitionError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	source := NewPublishSubject[int]()
+
+	matchesObservable, othersObservable := Partition(
+		source.AsObservable(),
+		func(item int) bool {
+			return item%2 == 0
+		},
+	)
+
+	var err1, err2 error
+
+	sub1 := matchesObservable.Subscribe(OnError[int](func(err error) { err1 = err }))
+	sub2 := othersObservable.Subscribe(OnError[int](func(err error) { err2 = err }))
+
+	source.Error(assert.AnError)
+
+	is.EqualError(err1, assert.AnError.Error())
+	is.EqualError(err2, assert.AnError.Error())
+
+	sub1.Unsubscribe()
+	sub2.Unsubscribe()
+}
+
+func TestOperatorTransformationBufferToggle(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	source := NewPublishSubject[int]()
+	openings := NewPublishSubject[string]()
+	closers := map[string]Subject[struct{}]{
+		"a": NewPublishSubject[struct{}](),
+		"b": NewPublishSubject[struct{}](),
+	}
+
+	values := [][]int{}
+	completed := false
+
+	sub := Pipe1(
+		source.AsObservable(),
+		BufferToggle[int, string, struct{}](openings, func(opening string) Observable[struct{}] {
+			return closers[opening]
+		}),
+	).Subscribe(NewObserver(
+		func(value []int) { values = append(values, value) },
+		func(err error) {},
+		func() { completed = true },
+	))
+
+	source.Next(1) // no buffer open, dropped
+	openings.Next("a")
+	source.Next(2)
+	source.Next(3)
+	openings.Next("b") // overlapping buffer
+	source.Next(4)
+	closers["a"].Next(struct{}{})
+	source.Next(5)
+	source.Complete() // flushes the "b" buffer
+
+	is.Equal([][]int{{2, 3, 4}, {4, 5}}, values)
+	is.True(completed)
+
+	sub.Unsubscribe()
+
+	// error discards open buffers
+	source = NewPublishSubject[int]()
+	openings = NewPublishSubject[string]()
+
+	values = [][]int{}
+
+	var finalErr error
+
+	sub = Pipe1(
+		source.AsObservable(),
+		BufferToggle[int, string, struct{}](openings, func(opening string) Observable[struct{}] {
+			return Never()
+		}),
+	).Subscribe(NewObserver(
+		func(value []int) { values = append(values, value) },
+		func(err error) { finalErr = err },
+		func() {},
+	))
+
+	openings.Next("a")
+	source.Next(1)
+	source.Error(assert.AnError)
+
+	is.Equal([][]int{}, values)
+	is.EqualError(finalErr, assert.AnError.Error())
+
+	sub.Unsubscribe()
+}
+
+func TestOperatorTransformationBatchByKey(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	byPrefix := func(item string) string {
+		return item[:1]
+	}
+
+	// full batches are flushed immediately, the rest on completion in
+	// first-item order
+	values, err := Collect(
+		Pipe1(
+			Just("a1", "b1", "a2", "a3"),
+			BatchByKey(byPrefix, 2, time.Minute),
+		),
+	)
+	is.Equal([]lo.Tuple2[string, []string]{
+		lo.T2("a", []string{"a1", "a2"}),
+		lo.T2("b", []string{"b1"}),
+		lo.T2("a", []string{"a3"}),
+	}, values)
+	is.NoError(err)
+
+	// a batch is flushed when the interval has elapsed since its first item
+	source := NewPublishSubject[string]()
+	mu := sync.Mutex{}
+	output := []lo.Tuple2[string, []string]{}
+
+	sub := Pipe1(
+		source.AsObservable(),
+		BatchByKey(byPrefix, 10, 20*time.Millisecond),
+	).Subscribe(NewObserver(
+		func(value lo.Tuple2[string, []string]) {
+			mu.Lock()
+			output = append(output, value)
+			mu.Unlock()
+		},
+		func(err error) {},
+		func() {},
+	))
+
+	source.Next("a1")
+	source.Next("a2")
+	time.Sleep(50 * time.Millisecond)
+
+	mu.Lock()
+	is.Equal([]lo.Tuple2[string, []string]{lo.T2("a", []string{"a1", "a2"})}, output)
+	mu.Unlock()
+
+	source.Complete()
+	sub.Wait()
+
+	// pending batches are dropped on error
+	values, err = Collect(
+		Pipe2(
+			Just("a1"),
+			ConcatWith(Throw[string](assert.AnError)),
+			BatchByKey(byPrefix, 10, time.Minute),
+		),
+	)
+	is.Equal([]lo.Tuple2[string, []string]{}, values)
 	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrBatchByKeyWrongSize.Error(), func() {
+		BatchByKey(byPrefix, 0, time.Minute)
+	})
+	is.PanicsWithError(ErrBatchByKeyWrongInterval.Error(), func() {
+		BatchByKey(byPrefix, 1, 0)
+	})
 }
 
 func TestOperatorTransformationBufferWithTimeOrCount(t *testing.T) { //nolint:paralleltest
@@ -597,6 +1367,60 @@ func TestOperatorTransformationBufferWithCount(t *testing.T) { //nolint:parallel
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorTransformationBufferWithCountAndSkip(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	// sliding window: overlapping buffers, partials flushed on completion
+	values, err := Collect(
+		BufferWithCountAndSkip[int](3, 1)(Just(1, 2, 3, 4, 5)),
+	)
+	is.Equal([][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5}, {5}}, values)
+	is.NoError(err)
+
+	// gapped windows: items between buffers are skipped
+	values, err = Collect(
+		BufferWithCountAndSkip[int](2, 3)(Just(1, 2, 3, 4, 5, 6, 7)),
+	)
+	is.Equal([][]int{{1, 2}, {4, 5}, {7}}, values)
+	is.NoError(err)
+
+	// startEvery == size behaves like BufferWithCount
+	values, err = Collect(
+		BufferWithCountAndSkip[int](2, 2)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{{1, 2}, {3}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		BufferWithCountAndSkip[int](3, 1)(Empty[int]()),
+	)
+	is.Equal([][]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		BufferWithCountAndSkip[int](0, 1)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, "ro.BufferWithCountAndSkip: size must be greater than 0")
+
+	values, err = Collect(
+		BufferWithCountAndSkip[int](1, 0)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, "ro.BufferWithCountAndSkip: startEvery must be greater than 0")
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			BufferWithCountAndSkip[int](2, 1),
+		),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorTransformationBufferWithTime(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 2000*time.Millisecond)
@@ -648,10 +1472,201 @@ func TestOperatorTransformationBufferWithTime(t *testing.T) { //nolint:parallelt
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorTransformationHoppingWindow(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 2000*time.Millisecond)
+	is := assert.New(t)
+
+	// windows overlap when hop < windowSize
+	values, err := Collect(
+		Pipe1(
+			RangeWithInterval(1, 4, 50*time.Millisecond),
+			HoppingWindow[int64](time.Second, 60*time.Millisecond),
+		),
+	)
+	is.Equal([][]int64{{1}, {1, 2}, {1, 2, 3}}, values)
+	is.NoError(err)
+
+	// values older than windowSize are evicted
+	values, err = Collect(
+		Pipe1(
+			RangeWithInterval(1, 3, 100*time.Millisecond),
+			HoppingWindow[int64](90*time.Millisecond, 60*time.Millisecond),
+		),
+	)
+	is.Equal([][]int64{{}, {1}, {1}, {2}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Empty[int64](),
+			HoppingWindow[int64](time.Second, time.Second),
+		),
+	)
+	is.Equal([][]int64{{}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int64](assert.AnError),
+			HoppingWindow[int64](time.Second, time.Second),
+		),
+	)
+	is.Equal([][]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrHoppingWindowWrongWindowSize.Error(), func() {
+		HoppingWindow[int](0, time.Second)
+	})
+	is.PanicsWithError(ErrHoppingWindowWrongHop.Error(), func() {
+		HoppingWindow[int](time.Second, 0)
+	})
+}
+
+func TestOperatorTransformationTumblingBillingWindow(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	boundary := func(t time.Time) time.Time { return t.Truncate(100 * time.Millisecond) }
+
+	windows, err := Collect(
+		Pipe1(
+			RangeWithInterval(0, 4, 30*time.Millisecond),
+			TumblingBillingWindow[int64](100*time.Millisecond, boundary),
+		),
+	)
+	is.NoError(err)
+
+	// the subscription may start anywhere in a wall-clock window, so only
+	// the alignment and ordering of the windows are deterministic
+	flat := []int64{}
+	for i, window := range windows {
+		is.True(window.A.Equal(boundary(window.A)))
+
+		if i > 0 {
+			is.True(window.A.After(windows[i-1].A))
+		}
+
+		flat = append(flat, window.B...)
+	}
+	is.Equal([]int64{0, 1, 2, 3}, flat)
+
+	// an empty source still flushes the current window
+	windows, err = Collect(
+		Pipe1(
+			Empty[int64](),
+			TumblingBillingWindow[int64](100*time.Millisecond, boundary),
+		),
+	)
+	is.Len(windows, 1)
+	is.Empty(windows[0].B)
+	is.NoError(err)
+
+	windows, err = Collect(
+		Pipe1(
+			Throw[int64](assert.AnError),
+			TumblingBillingWindow[int64](100*time.Millisecond, boundary),
+		),
+	)
+	is.Equal([]lo.Tuple2[time.Time, []int64]{}, windows)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrTumblingBillingWindowWrongWindow.Error(), func() {
+		TumblingBillingWindow[int64](0, boundary)
+	})
+	is.PanicsWithError(ErrTumblingBillingWindowMissingBoundary.Error(), func() {
+		TumblingBillingWindow[int64](100*time.Millisecond, nil)
+	})
+}
+
 func TestOperatorTransformationWindowWhen(t *testing.T) { //nolint:paralleltest
 	// @TODO: Implement tests
 }
 
+func TestOperatorTransformationWindowWithCount(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe3(
+			Just(1, 2, 3, 4, 5),
+			WindowWithCount[int](2),
+			Map(func(window Observable[int]) Observable[int] {
+				return Pipe1(window, Sum[int]())
+			}),
+			MergeAll[int](),
+		),
+	)
+	is.Equal([]int{3, 7, 5}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe2(
+			Just(1, 2, 3),
+			WindowWithCount[int](3),
+			MergeAll[int](),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe2(
+			Empty[int](),
+			WindowWithCount[int](2),
+			MergeAll[int](),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	is.PanicsWithError("ro.WindowWithCount: size must be greater than 0", func() {
+		WindowWithCount[int](0)(Just(1, 2, 3))
+	})
+
+	values, err = Collect(
+		Pipe2(
+			Throw[int](assert.AnError),
+			WindowWithCount[int](2),
+			MergeAll[int](),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorTransformationWindowWithTime(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 2000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe2(
+			RangeWithInterval(1, 4, 50*time.Millisecond),
+			WindowWithTime[int64](125*time.Millisecond),
+			MergeAll[int64](),
+		),
+	)
+	is.Equal([]int64{1, 2, 3}, values)
+	is.NoError(err)
+
+	is.PanicsWithError("ro.WindowWithTime: duration must be greater than 0", func() {
+		WindowWithTime[int64](0)(Just[int64](1, 2, 3))
+	})
+
+	values, err = Collect(
+		Pipe2(
+			Throw[int64](assert.AnError),
+			WindowWithTime[int64](50*time.Millisecond),
+			MergeAll[int64](),
+		),
+	)
+	is.Equal([]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorTransformationSampleWhen(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 1500*time.Millisecond)
@@ -779,6 +1794,146 @@ func TestOperatorTransformationSampleTime(t *testing.T) { //nolint:paralleltest
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorTransformationSampleOrLatest(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	source := NewPublishSubject[int]()
+	mu := sync.Mutex{}
+	output := []int{}
+	completed := false
+
+	sub := Pipe1(
+		source.AsObservable(),
+		SampleOrLatest[int](30*time.Millisecond),
+	).Subscribe(NewObserver(
+		func(value int) {
+			mu.Lock()
+			output = append(output, value)
+			mu.Unlock()
+		},
+		func(err error) {},
+		func() { completed = true },
+	))
+
+	// no keepalive before the first value
+	time.Sleep(80 * time.Millisecond)
+
+	mu.Lock()
+	is.Equal([]int{}, output)
+	mu.Unlock()
+
+	// values pass through immediately, then get re-emitted as keepalives
+	source.Next(1)
+	time.Sleep(100 * time.Millisecond)
+
+	mu.Lock()
+	is.GreaterOrEqual(len(output), 3)
+	for _, value := range output {
+		is.Equal(1, value)
+	}
+	count := len(output)
+	mu.Unlock()
+
+	source.Next(2)
+	source.Complete()
+	sub.Wait()
+
+	is.True(completed)
+
+	mu.Lock()
+	is.Equal(2, output[len(output)-1])
+	is.GreaterOrEqual(len(output), count+1)
+	mu.Unlock()
+
+	values, err := Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			SampleOrLatest[int](20*time.Millisecond),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrSampleOrLatestWrongPeriod.Error(), func() {
+		SampleOrLatest[int](0)
+	})
+}
+
+func TestOperatorTransformationResample(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 2000*time.Millisecond)
+	is := assert.New(t)
+
+	// hold: the last sample is repeated at every tick
+	source := NewPublishSubject[float64]()
+	values := []float64{}
+	completed := false
+
+	sub := Pipe1(
+		source.AsObservable(),
+		Resample[float64](50*time.Millisecond, InterpolationHold),
+	).Subscribe(NewObserver(
+		func(value float64) { values = append(values, value) },
+		func(err error) {},
+		func() { completed = true },
+	))
+
+	source.Next(1)
+	time.Sleep(130 * time.Millisecond) // ticks at 50ms and 100ms
+	source.Next(2)
+	time.Sleep(45 * time.Millisecond) // tick at 150ms
+	source.Complete()
+
+	is.Equal([]float64{1, 1, 2}, values)
+	is.True(completed)
+
+	sub.Unsubscribe()
+
+	// linear: tick values are interpolated between the surrounding samples,
+	// so they are emitted with a one-sample lag
+	source = NewPublishSubject[float64]()
+	values = []float64{}
+
+	sub = Pipe1(
+		source.AsObservable(),
+		Resample[float64](50*time.Millisecond, InterpolationLinear),
+	).Subscribe(OnNext(func(value float64) {
+		values = append(values, value)
+	}))
+
+	source.Next(0)
+	time.Sleep(130 * time.Millisecond) // ticks at 50ms and 100ms are emitted on the next sample
+	source.Next(10)
+	source.Complete()
+
+	is.Len(values, 2)
+
+	for _, value := range values {
+		is.Greater(value, 0.0)
+		is.Less(value, 10.0)
+	}
+
+	is.True(len(values) < 2 || values[0] < values[1])
+
+	sub.Unsubscribe()
+
+	is.PanicsWithError("ro.Resample: period must be greater than 0", func() {
+		Resample[float64](0, InterpolationHold)
+	})
+
+	is.PanicsWithError("ro.Resample: unexpected interpolation mode", func() {
+		Resample[float64](time.Second, InterpolationMode(42))
+	})
+
+	floatValues, err := Collect(
+		Resample[float64](50*time.Millisecond, InterpolationLinear)(Throw[float64](assert.AnError)),
+	)
+	is.Equal([]float64{}, floatValues)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorTransformationThrottleWhen(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 1000*time.Millisecond)
@@ -862,3 +2017,97 @@ func TestOperatorTransformationThrottleTime(t *testing.T) { //nolint:paralleltes
 	is.Equal([]int64{}, values)
 	is.EqualError(err, assert.AnError.Error())
 }
+
+func TestOperatorTransformationRateLimit(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// no value is dropped, pacing only
+	start := time.Now()
+	values, err := Collect(
+		Pipe1(
+			Just[int64](1, 2, 3, 4),
+			RateLimit[int64](100, 1),
+		),
+	)
+	elapsed := time.Since(start)
+	is.Equal([]int64{1, 2, 3, 4}, values)
+	is.NoError(err)
+	// first value consumes the burst, the next 3 wait ~10ms each
+	is.GreaterOrEqual(elapsed, 25*time.Millisecond)
+
+	// burst lets the first values through immediately
+	start = time.Now()
+	values, err = Collect(
+		Pipe1(
+			Just[int64](1, 2, 3, 4),
+			RateLimit[int64](100, 4),
+		),
+	)
+	elapsed = time.Since(start)
+	is.Equal([]int64{1, 2, 3, 4}, values)
+	is.NoError(err)
+	is.Less(elapsed, 25*time.Millisecond)
+
+	values, err = Collect(
+		Pipe1(
+			Empty[int64](),
+			RateLimit[int64](100, 1),
+		),
+	)
+	is.Equal([]int64{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int64](assert.AnError),
+			RateLimit[int64](100, 1),
+		),
+	)
+	is.Equal([]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithError(ErrRateLimitWrongRate.Error(), func() {
+		RateLimit[int64](0, 1)
+	})
+	is.PanicsWithError(ErrRateLimitWrongBurst.Error(), func() {
+		RateLimit[int64](100, 0)
+	})
+}
+
+func TestOperatorTransformationIntersperse(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Intersperse(0)(Just(1, 2, 3)),
+	)
+	is.Equal([]int{1, 0, 2, 0, 3}, values)
+	is.NoError(err)
+
+	// no separator around a single element
+	values, err = Collect(
+		Intersperse(0)(Just(1)),
+	)
+	is.Equal([]int{1}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Intersperse(0)(Empty[int]()),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Intersperse(0)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	strings, err := Collect(
+		Intersperse(", ")(Just("a", "b", "c")),
+	)
+	is.Equal([]string{"a", ", ", "b", ", ", "c"}, strings)
+	is.NoError(err)
+}
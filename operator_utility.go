@@ -218,6 +218,40 @@ func DoOnFinalize[T any](onFinalize func()) func(Observable[T]) Observable[T] {
 	return TapOnFinalize[T](onFinalize)
 }
 
+// Finalize invokes the callback exactly once when the stream terminates,
+// whatever the reason: completion, error, or downstream unsubscription. On
+// completion and error, the callback runs after the terminal notification has
+// been forwarded downstream; on unsubscription, it runs after the source is
+// unsubscribed, even if the source never emitted. This makes it a safe place
+// to release resources (files, connections, ...) used by the pipeline.
+func Finalize[T any](onFinalize func()) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var once sync.Once
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					destination.NextWithContext,
+					func(ctx context.Context, err error) {
+						destination.ErrorWithContext(ctx, err)
+						once.Do(onFinalize)
+					},
+					func(ctx context.Context) {
+						destination.CompleteWithContext(ctx)
+						once.Do(onFinalize)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+				once.Do(onFinalize)
+			}
+		})
+	}
+}
+
 // IntervalValue is a value emitted by the `TimeInterval` operator.
 type IntervalValue[T any] struct {
 	Value    T
@@ -366,6 +400,139 @@ func Delay[T any](duration time.Duration) func(Observable[T]) Observable[T] {
 	}
 }
 
+// DelayBy delays each value emitted by the source Observable by a duration
+// computed from the value itself (eg: release calendar events at their start
+// time). It is a convenience around DelayWhen: values may be emitted out of
+// order if the computed delays do not preserve the source order, completion
+// waits for all pending values, and pending timers are canceled on teardown.
+func DelayBy[T any](selector func(item T) time.Duration) func(Observable[T]) Observable[T] {
+	return DelayWhen(func(item T) Observable[time.Duration] {
+		return Timer(selector(item))
+	})
+}
+
+// DelayWhen delays each value emitted by the source Observable until the
+// Observable returned by delayDurationSelector for that value emits its first
+// value or completes. This allows per-item delays computed from the value
+// itself. Since each value waits on its own trigger, values may be emitted out
+// of order if the user's delays do not preserve the source order. Completion is
+// deferred until all pending values have been emitted, and pending inner
+// subscriptions are cleaned up on teardown.
+func DelayWhen[T any, U any](delayDurationSelector func(item T) Observable[U]) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+			subscriptions := NewSubscription(nil)
+
+			pending := 0
+			sourceDone := false
+			done := false
+
+			subscriptions.AddUnsubscribable(
+				source.SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, value T) {
+							mu.Lock()
+
+							if done {
+								mu.Unlock()
+								return
+							}
+
+							pending++
+
+							mu.Unlock()
+
+							fired := false
+
+							emit := func(ctx context.Context) {
+								mu.Lock()
+								defer mu.Unlock()
+
+								if fired || done {
+									return
+								}
+
+								fired = true
+								pending--
+
+								destination.NextWithContext(ctx, value)
+
+								if sourceDone && pending == 0 {
+									done = true
+
+									destination.CompleteWithContext(ctx)
+								}
+							}
+
+							trigger := delayDurationSelector(value)
+
+							// the trigger may block (eg: Timer), so it gets its
+							// own goroutine to avoid serializing the delays
+							go func() {
+								subscriptions.AddUnsubscribable(
+									trigger.SubscribeWithContext(
+										ctx,
+										NewObserverWithContext(
+											func(ctx context.Context, _ U) {
+												emit(ctx)
+											},
+											func(ctx context.Context, err error) {
+												mu.Lock()
+												defer mu.Unlock()
+
+												if done {
+													return
+												}
+
+												done = true
+
+												destination.ErrorWithContext(ctx, err)
+											},
+											emit,
+										),
+									),
+								)
+							}()
+						},
+						func(ctx context.Context, err error) {
+							mu.Lock()
+							defer mu.Unlock()
+
+							if done {
+								return
+							}
+
+							done = true
+
+							destination.ErrorWithContext(ctx, err)
+						},
+						func(ctx context.Context) {
+							mu.Lock()
+							defer mu.Unlock()
+
+							if done {
+								return
+							}
+
+							sourceDone = true
+
+							if pending == 0 {
+								done = true
+
+								destination.CompleteWithContext(ctx)
+							}
+						},
+					),
+				),
+			)
+
+			return subscriptions.Unsubscribe
+		})
+	}
+}
+
 // DelayEach delays the emissions of the source Observable by a given duration without modifying the emitted items.
 // Play: https://go.dev/play/p/dReP7-bffEU
 func DelayEach[T any](duration time.Duration) func(Observable[T]) Observable[T] {
@@ -432,7 +599,89 @@ func RepeatWith[T any](count int64) func(Observable[T]) Observable[T] {
 	}
 }
 
+// ResubscribeOnComplete transparently re-subscribes to a source that
+// completed (not errored) when shouldResubscribe returns true, after waiting
+// for the given delay. It turns any finite source into a continuous one under
+// a controllable policy, e.g. a reconnecting stream client. Errors are
+// propagated immediately and stop the resubscription loop.
+func ResubscribeOnComplete[T any](source Observable[T], shouldResubscribe func() bool, delay time.Duration) Observable[T] {
+	return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+		mu := xsync.NewMutexWithSpinlock()
+		done := make(chan struct{})
+		stopped := false
+
+		var current Subscription
+
+		go recoverUnhandledError(func() {
+			lastCtx := subscriberCtx
+
+			for {
+				sub := source.SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						destination.NextWithContext,
+						destination.ErrorWithContext,
+						func(ctx context.Context) {
+							lastCtx = ctx
+						},
+					),
+				)
+
+				mu.Lock()
+
+				if stopped {
+					mu.Unlock()
+					sub.Unsubscribe()
+
+					return
+				}
+
+				current = sub
+
+				mu.Unlock()
+
+				sub.Wait()
+
+				if destination.IsClosed() {
+					return
+				}
+
+				if !shouldResubscribe() {
+					destination.CompleteWithContext(lastCtx)
+					return
+				}
+
+				select {
+				case <-done:
+					return
+				case <-subscriberCtx.Done():
+					return
+				case <-time.After(delay):
+				}
+			}
+		})
+
+		return func() {
+			mu.Lock()
+
+			stopped = true
+			sub := current
+
+			mu.Unlock()
+
+			close(done)
+
+			if sub != nil {
+				sub.Unsubscribe()
+			}
+		}
+	})
+}
+
 // Timeout raises an error if the source Observable does not emit any item within the specified duration.
+// The timer restarts after every emission, so the duration bounds each gap between items, not the whole
+// stream. The emitted error matches ro.ErrTimeout with errors.Is. See TimeoutFirst to only guard the
+// first emission, and TimeoutWith to switch to a fallback Observable instead of erroring.
 // Play: https://go.dev/play/p/t0xKoj-_AqZ
 func Timeout[T any](duration time.Duration) func(Observable[T]) Observable[T] {
 	return func(source Observable[T]) Observable[T] {
@@ -476,6 +725,301 @@ func Timeout[T any](duration time.Duration) func(Observable[T]) Observable[T] {
 	}
 }
 
+// Heartbeat passes source values through and injects a synthetic marker value
+// whenever the gap between emissions exceeds the given duration. Markers keep
+// coming every duration while the source stays silent, and the timer resets on
+// every real value. See IdleTimeout to error on silence instead.
+func Heartbeat[T any](duration time.Duration, makeMarker func() T) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+			stopped := false
+
+			var lastCtx atomic.Value
+
+			lastCtx.Store(subscriberCtx) // if no value is emitted, we use the subscriber context
+
+			var timer *time.Timer
+
+			timer = time.AfterFunc(duration, func() {
+				mu.Lock()
+
+				if stopped {
+					mu.Unlock()
+					return
+				}
+
+				mu.Unlock()
+
+				destination.NextWithContext(lastCtx.Load().(context.Context), makeMarker()) //nolint:errcheck,forcetypeassert
+
+				mu.Lock()
+
+				if !stopped {
+					timer.Reset(duration)
+				}
+
+				mu.Unlock()
+			})
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						timer.Stop()
+						destination.NextWithContext(ctx, value)
+						lastCtx.Store(ctx)
+
+						mu.Lock()
+
+						if !stopped {
+							timer.Reset(duration)
+						}
+
+						mu.Unlock()
+					},
+					func(ctx context.Context, err error) {
+						mu.Lock()
+						stopped = true
+						mu.Unlock()
+
+						timer.Stop()
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+						stopped = true
+						mu.Unlock()
+
+						timer.Stop()
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				mu.Lock()
+				stopped = true
+				mu.Unlock()
+
+				timer.Stop()
+				sub.Unsubscribe()
+			}
+		})
+	}
+}
+
+// IdleTimeout raises ro.ErrIdleTimeout if the gap between emissions of the
+// source Observable exceeds the given duration. It behaves like Timeout but
+// emits a bare sentinel that can be matched with errors.Is, without wrapping.
+// See Heartbeat to inject synthetic markers on silence instead.
+func IdleTimeout[T any](duration time.Duration) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var lastCtx atomic.Value
+
+			lastCtx.Store(subscriberCtx) // if no value is emitted, we use the subscriber context
+
+			timer := time.AfterFunc(duration, func() {
+				destination.ErrorWithContext(lastCtx.Load().(context.Context), ErrIdleTimeout) //nolint:errcheck,forcetypeassert
+			})
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						timer.Stop()
+						destination.NextWithContext(ctx, value)
+						timer.Reset(duration)
+						lastCtx.Store(ctx)
+					},
+					func(ctx context.Context, err error) {
+						timer.Stop()
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						timer.Stop()
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				timer.Stop()
+				sub.Unsubscribe()
+			}
+		})
+	}
+}
+
+// TimeoutEach is an explicitly named alias of Timeout: the timer restarts after every
+// emission, so the duration applies to each gap between items.
+func TimeoutEach[T any](duration time.Duration) func(Observable[T]) Observable[T] {
+	return Timeout[T](duration)
+}
+
+// TimeoutFirst raises an error if the source Observable does not emit its first item within
+// the specified duration. Once the first item has been emitted, the timer is disarmed and
+// later gaps are not bounded. The emitted error matches ro.ErrTimeout with errors.Is.
+func TimeoutFirst[T any](duration time.Duration) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			timer := time.AfterFunc(duration, func() {
+				destination.ErrorWithContext(subscriberCtx, newTimeoutError(duration))
+			})
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						timer.Stop()
+						destination.NextWithContext(ctx, value)
+					},
+					func(ctx context.Context, err error) {
+						timer.Stop()
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						timer.Stop()
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				timer.Stop()
+				sub.Unsubscribe()
+			}
+		})
+	}
+}
+
+// TimeoutWith mirrors the source Observable, but switches to the fallback Observable
+// instead of erroring when the source does not emit any item within the specified
+// duration. Like Timeout, the timer restarts after every emission.
+func TimeoutWith[T any](duration time.Duration, fallback Observable[T]) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+			subscriptions := NewSubscription(nil)
+			switched := false
+
+			var sourceSub Subscription
+
+			var timer *time.Timer
+
+			var lastCtx atomic.Value
+
+			lastCtx.Store(subscriberCtx) // if no value is emitted, we use the subscriber context
+
+			timer = time.AfterFunc(duration, func() {
+				mu.Lock()
+
+				if switched {
+					mu.Unlock()
+					return
+				}
+
+				switched = true
+				sub := sourceSub
+
+				mu.Unlock()
+
+				// drop the slow source and mirror the fallback instead
+				if sub != nil {
+					sub.Unsubscribe()
+				}
+
+				subscriptions.AddUnsubscribable(
+					fallback.SubscribeWithContext(
+						lastCtx.Load().(context.Context), //nolint:errcheck,forcetypeassert
+						NewObserverWithContext(
+							destination.NextWithContext,
+							destination.ErrorWithContext,
+							destination.CompleteWithContext,
+						),
+					),
+				)
+			})
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						mu.Lock()
+
+						if switched {
+							mu.Unlock()
+							return
+						}
+
+						timer.Stop()
+
+						mu.Unlock()
+
+						destination.NextWithContext(ctx, value)
+
+						mu.Lock()
+
+						if !switched {
+							timer.Reset(duration)
+							lastCtx.Store(ctx)
+						}
+
+						mu.Unlock()
+					},
+					func(ctx context.Context, err error) {
+						mu.Lock()
+
+						if switched {
+							mu.Unlock()
+							return
+						}
+
+						switched = true
+						timer.Stop()
+
+						mu.Unlock()
+
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+
+						if switched {
+							mu.Unlock()
+							return
+						}
+
+						switched = true
+						timer.Stop()
+
+						mu.Unlock()
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			mu.Lock()
+			sourceSub = sub
+			mu.Unlock()
+
+			return func() {
+				mu.Lock()
+
+				switched = true
+
+				mu.Unlock()
+
+				timer.Stop()
+				sub.Unsubscribe()
+				subscriptions.Unsubscribe()
+			}
+		})
+	}
+}
+
 // Materialize converts the source Observable into a stream of Notification instances.
 // Play: https://go.dev/play/p/ZHtPviPoqWK
 func Materialize[T any]() func(Observable[T]) Observable[Notification[T]] {
@@ -574,6 +1118,112 @@ func ObserveOn[T any](bufferSize int) func(Observable[T]) Observable[T] {
 	return detachOn[T](bufferSize, false, true)
 }
 
+// ObserveOnScheduler delivers the downstream notifications through the given
+// Scheduler instead of the producer goroutine, so a slow consumer does not
+// block the producer. Notifications are queued and drained by a single task
+// at a time, so per-subscription ordering is preserved even with a worker
+// pool. Subscription.Wait returns once the terminal notification has been
+// delivered on the scheduler. On teardown, queued notifications are abandoned.
+func ObserveOnScheduler[T any](scheduler Scheduler) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			mu := xsync.NewMutexWithSpinlock()
+			queue := []lo.Tuple2[context.Context, Notification[T]]{}
+			draining := false
+			stopped := false
+
+			drain := func() {
+				for {
+					mu.Lock()
+
+					if stopped || len(queue) == 0 {
+						draining = false
+						mu.Unlock()
+						return
+					}
+
+					head := queue[0]
+					queue = queue[1:]
+
+					mu.Unlock()
+
+					processNotificationWithObserverAndContext(head.A, head.B, destination)
+				}
+			}
+
+			push := func(ctx context.Context, notif Notification[T]) {
+				mu.Lock()
+
+				if stopped {
+					mu.Unlock()
+					return
+				}
+
+				queue = append(queue, lo.T2(ctx, notif))
+				shouldSchedule := !draining
+				draining = true
+
+				mu.Unlock()
+
+				if shouldSchedule {
+					scheduler.Schedule(drain)
+				}
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						push(ctx, NewNotificationNext(value))
+					},
+					func(ctx context.Context, err error) {
+						push(ctx, NewNotificationError[T](err))
+					},
+					func(ctx context.Context) {
+						push(ctx, NewNotificationComplete[T]())
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				mu.Lock()
+
+				stopped = true
+				queue = []lo.Tuple2[context.Context, Notification[T]]{}
+
+				mu.Unlock()
+			}
+		})
+	}
+}
+
+// SubscribeOnScheduler performs the subscription to the source Observable on
+// the given Scheduler instead of the caller goroutine, so a blocking source
+// does not block the caller. The returned Subscription can be awaited with
+// Subscription.Wait as usual; unsubscribing before the scheduled task runs
+// abandons the subscription.
+func SubscribeOnScheduler[T any](scheduler Scheduler) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			subscriptions := NewSubscription(nil)
+
+			scheduler.Schedule(func() {
+				if subscriptions.IsClosed() {
+					return
+				}
+
+				subscriptions.AddUnsubscribable(
+					source.SubscribeWithContext(subscriberCtx, destination),
+				)
+			})
+
+			return subscriptions.Unsubscribe
+		})
+	}
+}
+
 func detachOn[T any](bufferSize int, onUpstream, onDownstream bool) func(Observable[T]) Observable[T] {
 	return func(source Observable[T]) Observable[T] {
 		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
@@ -15,6 +15,7 @@
 package ro
 
 import (
+	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -237,6 +238,49 @@ func TestOperatorUtilityTapOnFinalize(t *testing.T) {
 	is.EqualValues(6, atomic.LoadInt32(&count))
 }
 
+func TestOperatorUtilityFinalize(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// fires once after the downstream complete callback
+	events := []string{}
+	sub := Finalize[int](func() {
+		events = append(events, "finalize")
+	})(Just(1, 2, 3)).Subscribe(NewObserver(
+		func(value int) {},
+		func(err error) {},
+		func() { events = append(events, "complete") },
+	))
+	sub.Unsubscribe()
+	is.Equal([]string{"complete", "finalize"}, events)
+
+	// fires once after the downstream error callback
+	events = []string{}
+	sub = Finalize[int](func() {
+		events = append(events, "finalize")
+	})(Throw[int](assert.AnError)).Subscribe(NewObserver(
+		func(value int) {},
+		func(err error) { events = append(events, "error") },
+		func() {},
+	))
+	sub.Unsubscribe()
+	is.Equal([]string{"error", "finalize"}, events)
+
+	// fires on unsubscription, even before the source emits
+	var count int32
+	finalizeSub := Finalize[struct{}](func() {
+		atomic.AddInt32(&count, 1)
+	})(Never()).Subscribe(NewObserver(
+		func(value struct{}) {},
+		func(err error) {},
+		func() {},
+	))
+	is.EqualValues(0, atomic.LoadInt32(&count))
+	finalizeSub.Unsubscribe()
+	finalizeSub.Unsubscribe()
+	is.EqualValues(1, atomic.LoadInt32(&count))
+}
+
 func TestOperatorUtilityTimeInterval(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 500*time.Millisecond)
@@ -355,6 +399,102 @@ func TestOperatorUtilityDelay(t *testing.T) { //nolint:paralleltest
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorUtilityDelayBy(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// per-item delays may reorder emissions
+	values, err := Collect(
+		Pipe1(
+			Just(3, 2, 1),
+			DelayBy(func(item int) time.Duration {
+				return time.Duration(item) * 50 * time.Millisecond
+			}),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			DelayBy(func(item int) time.Duration {
+				return time.Millisecond
+			}),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorUtilityDelayWhen(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// a trigger that completes immediately passes values through, but each
+	// trigger runs on its own goroutine, so the relative order is not guaranteed
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			DelayWhen(func(item int) Observable[struct{}] {
+				return Empty[struct{}]()
+			}),
+		),
+	)
+	is.ElementsMatch([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// per-item delays may reorder emissions
+	values, err = Collect(
+		Pipe1(
+			Just(3, 2, 1),
+			DelayWhen(func(item int) Observable[time.Duration] {
+				return Timer(time.Duration(item) * 50 * time.Millisecond)
+			}),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// completion waits for pending values
+	values, err = Collect(
+		Pipe1(
+			Just(1),
+			DelayWhen(func(item int) Observable[time.Duration] {
+				return Timer(50 * time.Millisecond)
+			}),
+		),
+	)
+	is.Equal([]int{1}, values)
+	is.NoError(err)
+
+	// source errors propagate immediately
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			DelayWhen(func(item int) Observable[struct{}] {
+				return Empty[struct{}]()
+			}),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// trigger errors propagate as well
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			DelayWhen(func(item int) Observable[struct{}] {
+				return Throw[struct{}](assert.AnError)
+			}),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorUtilityRepeatWith(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 200*time.Millisecond)
@@ -438,6 +578,58 @@ func TestOperatorUtilityRepeatWith(t *testing.T) { //nolint:paralleltest
 	)
 }
 
+func TestOperatorUtilityResubscribeOnComplete(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	// resubscribes until the policy declines
+	rounds := 0
+	values, err := Collect(
+		ResubscribeOnComplete(
+			Just(1, 2),
+			func() bool {
+				rounds++
+				return rounds < 3
+			},
+			1*time.Millisecond,
+		),
+	)
+	is.Equal([]int{1, 2, 1, 2, 1, 2}, values)
+	is.NoError(err)
+	is.Equal(3, rounds)
+
+	// errors stop the loop immediately, without consulting the policy
+	called := false
+	values, err = Collect(
+		ResubscribeOnComplete(
+			Throw[int](assert.AnError),
+			func() bool {
+				called = true
+				return true
+			},
+			1*time.Millisecond,
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.False(called)
+
+	// downstream unsubscription stops the loop
+	values, err = Collect(
+		Pipe1(
+			ResubscribeOnComplete(
+				Just(1, 2),
+				func() bool { return true },
+				1*time.Millisecond,
+			),
+			Take[int](5),
+		),
+	)
+	is.Equal([]int{1, 2, 1, 2, 1}, values)
+	is.NoError(err)
+}
+
 func TestOperatorUtilityTimeout(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 1000*time.Millisecond)
@@ -474,6 +666,211 @@ func TestOperatorUtilityTimeout(t *testing.T) { //nolint:paralleltest
 	)
 	is.Equal([]int64{}, values)
 	is.EqualError(err, assert.AnError.Error())
+
+	// the emitted error matches the exported sentinel
+	_, err = Collect(
+		Timeout[int64](10 * time.Millisecond)(
+			NewObservable(func(destination Observer[int64]) Teardown { return nil }),
+		),
+	)
+	is.True(errors.Is(err, ErrTimeout))
+	is.EqualError(err, "ro.Timeout: timeout after 10ms")
+}
+
+func TestOperatorUtilityHeartbeat(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// markers keep coming every duration while the source stays silent
+	values, err := Collect(
+		Heartbeat(50*time.Millisecond, func() int { return -1 })(
+			NewObservable(func(destination Observer[int]) Teardown {
+				destination.Next(1)
+				time.Sleep(130 * time.Millisecond)
+				destination.Next(2)
+				destination.Complete()
+				return nil
+			}),
+		),
+	)
+	is.Equal([]int{1, -1, -1, 2}, values)
+	is.NoError(err)
+
+	// a fast source does not trigger any marker
+	values2, err := Collect(
+		Heartbeat(100*time.Millisecond, func() int64 { return -1 })(
+			RangeWithInterval(1, 4, 10*time.Millisecond),
+		),
+	)
+	is.Equal([]int64{1, 2, 3}, values2)
+	is.NoError(err)
+
+	values2, err = Collect(
+		Heartbeat(50*time.Millisecond, func() int64 { return -1 })(
+			Empty[int64](),
+		),
+	)
+	is.Equal([]int64{}, values2)
+	is.NoError(err)
+
+	values2, err = Collect(
+		Heartbeat(50*time.Millisecond, func() int64 { return -1 })(
+			Throw[int64](assert.AnError),
+		),
+	)
+	is.Equal([]int64{}, values2)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorUtilityIdleTimeout(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		IdleTimeout[int64](100 * time.Millisecond)(
+			RangeWithInterval(1, 4, 10*time.Millisecond),
+		),
+	)
+	is.Equal([]int64{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		IdleTimeout[int64](10 * time.Millisecond)(
+			RangeWithInterval(1, 4, 100*time.Millisecond),
+		),
+	)
+	is.Equal([]int64{}, values)
+	is.True(errors.Is(err, ErrIdleTimeout))
+
+	values, err = Collect(
+		IdleTimeout[int64](10 * time.Millisecond)(
+			Throw[int64](assert.AnError),
+		),
+	)
+	is.Equal([]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorUtilityTimeoutEach(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// the timer restarts after every emission: each gap is 30ms, which is below
+	// the 50ms timeout, even though the whole stream takes longer than 50ms
+	values, err := Collect(
+		TimeoutEach[int64](50 * time.Millisecond)(
+			RangeWithInterval(1, 5, 30*time.Millisecond),
+		),
+	)
+	is.Equal([]int64{1, 2, 3, 4}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TimeoutEach[int64](10 * time.Millisecond)(
+			RangeWithInterval(1, 4, 100*time.Millisecond),
+		),
+	)
+	is.Equal([]int64{}, values)
+	is.True(errors.Is(err, ErrTimeout))
+}
+
+func TestOperatorUtilityTimeoutFirst(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// only the first emission is guarded: the 100ms gap after the first value
+	// does not trigger the 50ms timeout
+	values, err := Collect(
+		TimeoutFirst[int](50 * time.Millisecond)(
+			NewObservable(func(destination Observer[int]) Teardown {
+				destination.Next(1)
+				time.Sleep(100 * time.Millisecond)
+				destination.Next(2)
+				destination.Complete()
+				return nil
+			}),
+		),
+	)
+	is.Equal([]int{1, 2}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TimeoutFirst[int](10 * time.Millisecond)(
+			NewObservable(func(destination Observer[int]) Teardown { return nil }),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.True(errors.Is(err, ErrTimeout))
+
+	values, err = Collect(
+		TimeoutFirst[int](10 * time.Millisecond)(
+			Empty[int](),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TimeoutFirst[int](10 * time.Millisecond)(
+			Throw[int](assert.AnError),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorUtilityTimeoutWith(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	// fast source is mirrored unchanged
+	values, err := Collect(
+		TimeoutWith(100*time.Millisecond, Just[int64](42))(
+			RangeWithInterval(1, 4, 10*time.Millisecond),
+		),
+	)
+	is.Equal([]int64{1, 2, 3}, values)
+	is.NoError(err)
+
+	// slow source is replaced by the fallback instead of erroring
+	values, err = Collect(
+		TimeoutWith(10*time.Millisecond, Just[int64](42, 43))(
+			NewObservable(func(destination Observer[int64]) Teardown { return nil }),
+		),
+	)
+	is.Equal([]int64{42, 43}, values)
+	is.NoError(err)
+
+	// values emitted before the timeout are kept
+	values, err = Collect(
+		TimeoutWith(50*time.Millisecond, Just[int64](42))(
+			RangeWithInterval(1, 4, 100*time.Millisecond),
+		),
+	)
+	is.Equal([]int64{42}, values)
+	is.NoError(err)
+
+	// errors and completion are forwarded as-is
+	values, err = Collect(
+		TimeoutWith(10*time.Millisecond, Just[int64](42))(
+			Throw[int64](assert.AnError),
+		),
+	)
+	is.Equal([]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		TimeoutWith(10*time.Millisecond, Just[int64](42))(
+			Empty[int64](),
+		),
+	)
+	is.Equal([]int64{}, values)
+	is.NoError(err)
 }
 
 func TestOperatorUtilityMaterialize(t *testing.T) {
@@ -730,3 +1127,80 @@ func TestOperatorSchedulerObserveOn(t *testing.T) { //nolint:paralleltest
 
 	// @TODO: write some tests for channel buffer overflow
 }
+
+func TestOperatorSchedulerObserveOnScheduler(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 2000*time.Millisecond)
+	is := assert.New(t)
+
+	// synchronous scheduler is a passthrough
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			ObserveOnScheduler[int](NewSynchronousScheduler()),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// ordering is preserved with a worker pool
+	pool := NewWorkerPoolScheduler(4)
+
+	values64, err := Collect(
+		Pipe1(
+			Range(0, 1000),
+			ObserveOnScheduler[int64](pool),
+		),
+	)
+	is.Len(values64, 1000)
+	for i, value := range values64 {
+		is.Equal(int64(i), value)
+	}
+	is.NoError(err)
+
+	// errors are delivered through the scheduler as well
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			ObserveOnScheduler[int](pool),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	pool.Stop()
+
+	is.PanicsWithError(ErrWorkerPoolSchedulerWrongSize.Error(), func() {
+		NewWorkerPoolScheduler(0)
+	})
+}
+
+func TestOperatorSchedulerSubscribeOnScheduler(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 2000*time.Millisecond)
+	is := assert.New(t)
+
+	// a fresh goroutine unblocks the caller even with a blocking source
+	start := time.Now()
+	sub := Pipe1(
+		Pipe1(
+			Just(1, 2, 3),
+			DelayEach[int](20*time.Millisecond),
+		),
+		SubscribeOnScheduler[int](NewGoroutineScheduler()),
+	).Subscribe(NoopObserver[int]())
+
+	is.InDelta(0, time.Since(start), float64(15*time.Millisecond))
+	sub.Wait()
+	is.True(sub.IsClosed())
+
+	// synchronous scheduler behaves like a plain subscription
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			SubscribeOnScheduler[int](NewSynchronousScheduler()),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+}
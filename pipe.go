@@ -1215,6 +1215,20 @@ func Pipe25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W,
 	)
 }
 
+// PipeSlice builds a composition from a slice of operators that keep the same
+// item type. It serves pipelines built at runtime (e.g. config-driven ETL),
+// where the operator count is dynamic but the type stays constant, without
+// falling back to the reflection-based Pipe.
+//
+// `PipeOpSlice()` is the operator version of `PipeSlice()`.
+func PipeSlice[T any](source Observable[T], operators []func(Observable[T]) Observable[T]) Observable[T] {
+	for _, operator := range operators {
+		source = operator(source)
+	}
+
+	return source
+}
+
 // PipeOp is similar to Pipe, but can be used as an operator.
 func PipeOp[First, Last any](operators ...any) func(Observable[First]) Observable[Last] {
 	return func(source Observable[First]) Observable[Last] {
@@ -2121,3 +2135,23 @@ func PipeOp25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V,
 		)
 	}
 }
+
+// PipeOpSlice is similar to PipeSlice, but can be used as an operator.
+func PipeOpSlice[T any](operators []func(Observable[T]) Observable[T]) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return PipeSlice(source, operators)
+	}
+}
+
+// FlattenPipe composes a variadic list of same-typed operators into a single
+// operator, applying them iteratively instead of through nested calls. Very
+// long pipelines -- e.g. generated ones stacking `PipeOp24()` blocks -- grow the
+// call stack at composition time; FlattenPipe keeps the composition depth
+// constant whatever the operator count.
+//
+// `PipeSlice()` is the source version of `FlattenPipe()`.
+func FlattenPipe[T any](operators ...func(Observable[T]) Observable[T]) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return PipeSlice(source, operators)
+	}
+}
@@ -1324,6 +1324,169 @@ func TestPipeX(t *testing.T) {
 	}
 }
 
+func TestPipeSlice(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		PipeSlice(
+			Just(1, 2, 3),
+			[]func(Observable[int]) Observable[int]{},
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		PipeSlice(
+			Just(1, 2, 3),
+			[]func(Observable[int]) Observable[int]{
+				Map(func(x int) int {
+					return x * 2
+				}),
+				Take[int](2),
+			},
+		),
+	)
+	is.Equal([]int{2, 4}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		PipeSlice(
+			Throw[int](assert.AnError),
+			[]func(Observable[int]) Observable[int]{
+				passThrough[int](),
+			},
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestPipeOpSlice(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			PipeOpSlice([]func(Observable[int]) Observable[int]{
+				Map(func(x int) int {
+					return x * 2
+				}),
+				Take[int](2),
+			}),
+		),
+	)
+	is.Equal([]int{2, 4}, values)
+	is.NoError(err)
+}
+
+func TestFlattenPipe(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			FlattenPipe[int](),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			FlattenPipe(
+				Map(func(x int) int {
+					return x * 2
+				}),
+				Take[int](2),
+			),
+		),
+	)
+	is.Equal([]int{2, 4}, values)
+	is.NoError(err)
+
+	// a pipeline far deeper than any PipeX arity
+	operators := make([]func(Observable[int]) Observable[int], 1000)
+	for i := range operators {
+		operators[i] = Map(func(x int) int {
+			return x + 1
+		})
+	}
+
+	values, err = Collect(
+		Pipe1(
+			Just(0),
+			FlattenPipe(operators...),
+		),
+	)
+	is.Equal([]int{1000}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			FlattenPipe(passThrough[int]()),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func makeDeepOperatorChain(depth int) []func(Observable[int]) Observable[int] {
+	operators := make([]func(Observable[int]) Observable[int], depth)
+	for i := range operators {
+		operators[i] = passThrough[int]()
+	}
+
+	return operators
+}
+
+// BenchmarkFlattenPipe composes the chain iteratively: composition cost is a
+// single loop and the stack depth does not depend on the operator count.
+func BenchmarkFlattenPipe(b *testing.B) {
+	operators := makeDeepOperatorChain(1000)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = Collect(
+			Pipe1(
+				Just(1, 2, 3),
+				FlattenPipe(operators...),
+			),
+		)
+	}
+}
+
+// BenchmarkNestedPipeOp composes the same chain by folding PipeOp1 closures,
+// which nests one call frame per operator at composition time.
+func BenchmarkNestedPipeOp(b *testing.B) {
+	operators := makeDeepOperatorChain(1000)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		operator := passThrough[int]()
+		for _, op := range operators {
+			operator = PipeOp2(operator, op)
+		}
+
+		_, _ = Collect(
+			Pipe1(
+				Just(1, 2, 3),
+				operator,
+			),
+		)
+	}
+}
+
 func TestPipeOp(t *testing.T) { //nolint:paralleltest
 	// @TODO: implement
 }
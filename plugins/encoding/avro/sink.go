@@ -0,0 +1,63 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roavro
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hamba/avro/v2"
+	"github.com/samber/ro"
+)
+
+// NewAvroWriter encodes each value as an Avro binary record to a writer, using
+// the provided schema, forwarding the values downstream unchanged. An invalid
+// schema or a value that does not match it stops the stream with an error.
+func NewAvroWriter[T any](writer io.Writer, schema string) func(ro.Observable[T]) ro.Observable[T] {
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			fail := func(ctx context.Context, err error) {
+				destination.ErrorWithContext(ctx, fmt.Errorf("roavro.NewAvroWriter: %w", err))
+			}
+
+			parsed, err := avro.Parse(schema)
+			if err != nil {
+				fail(subscriberCtx, err)
+				return nil
+			}
+
+			encoder := avro.NewEncoderForSchema(parsed, writer)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						err := encoder.Encode(value)
+						if err != nil {
+							fail(ctx, err)
+						} else {
+							destination.NextWithContext(ctx, value)
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
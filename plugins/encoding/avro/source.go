@@ -0,0 +1,62 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roavro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/hamba/avro/v2"
+	"github.com/samber/ro"
+)
+
+// NewAvroReader creates an observable that decodes consecutive Avro binary
+// records from a reader into T, using the provided schema and `avro:"..."`
+// struct tags for the field mapping. The stream completes at the end of the
+// reader and errors on an invalid schema or a record that does not match it.
+func NewAvroReader[T any](reader io.Reader, schema string) ro.Observable[T] {
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[T]) ro.Teardown {
+		fail := func(err error) {
+			destination.ErrorWithContext(ctx, fmt.Errorf("roavro.NewAvroReader: %w", err))
+		}
+
+		parsed, err := avro.Parse(schema)
+		if err != nil {
+			fail(err)
+			return nil
+		}
+
+		decoder := avro.NewDecoderForSchema(parsed, reader)
+
+		for {
+			var value T
+
+			err := decoder.Decode(&value)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					destination.CompleteWithContext(ctx)
+				} else {
+					fail(err)
+				}
+
+				return nil
+			}
+
+			destination.NextWithContext(ctx, value)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roavro
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hamba/avro/v2"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+const userSchema = `{
+	"type": "record",
+	"name": "user",
+	"fields": [
+		{"name": "name", "type": "string"},
+		{"name": "age", "type": "long"}
+	]
+}`
+
+type user struct {
+	Name string `avro:"name"`
+	Age  int64  `avro:"age"`
+}
+
+func encodeUsers(t *testing.T, users []user) []byte {
+	t.Helper()
+
+	var buffer bytes.Buffer
+
+	parsed, err := avro.Parse(userSchema)
+	assert.NoError(t, err)
+
+	encoder := avro.NewEncoderForSchema(parsed, &buffer)
+	for _, u := range users {
+		assert.NoError(t, encoder.Encode(u))
+	}
+
+	return buffer.Bytes()
+}
+
+func TestNewAvroReader(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data := encodeUsers(t, []user{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+	})
+
+	values, err := ro.Collect(
+		NewAvroReader[user](bytes.NewReader(data), userSchema),
+	)
+	is.Equal([]user{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+	}, values)
+	is.Nil(err)
+
+	// empty input
+	values, err = ro.Collect(
+		NewAvroReader[user](bytes.NewReader(nil), userSchema),
+	)
+	is.Equal([]user{}, values)
+	is.Nil(err)
+
+	// an invalid schema stops the stream with an error
+	values, err = ro.Collect(
+		NewAvroReader[user](bytes.NewReader(data), `{"type": "nope"}`),
+	)
+	is.Equal([]user{}, values)
+	is.ErrorContains(err, "roavro.NewAvroReader")
+
+	// a corrupt record stops the stream with an error
+	corrupt := append(encodeUsers(t, []user{{Name: "Alice", Age: 30}}), 0x05) // 0x05 decodes to a negative string length
+
+	values, err = ro.Collect(
+		NewAvroReader[user](bytes.NewReader(corrupt), userSchema),
+	)
+	is.Equal([]user{{Name: "Alice", Age: 30}}, values)
+	is.ErrorContains(err, "roavro.NewAvroReader")
+}
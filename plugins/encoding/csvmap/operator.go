@@ -0,0 +1,76 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package rocsvmap
+
+import (
+	"reflect"
+
+	"github.com/samber/ro"
+)
+
+// EncodeRows converts a stream of structs into CSV rows using `csv:"..."`
+// struct tags, in field declaration order. Untagged exported fields use the
+// field name, `csv:"-"` skips a field, and time.Time fields honor a
+// `csv:"name,format=2006-01-02"` layout option. Nested structs are flattened
+// with dotted column names. Unexported or unsupported fields are silently
+// skipped; use EncodeRowsStrict to reject them.
+//
+// The schema is derived once, at construction; a non-struct T panics before
+// the pipeline runs.
+func EncodeRows[T any]() func(ro.Observable[T]) ro.Observable[[]string] {
+	columns := mustSchemaOf[T](false)
+
+	return ro.Map(func(item T) []string {
+		return encodeRow(reflect.ValueOf(item), columns)
+	})
+}
+
+// EncodeRowsStrict is like EncodeRows, but panics at construction when a
+// tagged field is unexported or of an unsupported type, so that schema
+// mistakes surface before the pipeline runs instead of producing silently
+// incomplete rows.
+func EncodeRowsStrict[T any]() func(ro.Observable[T]) ro.Observable[[]string] {
+	columns := mustSchemaOf[T](true)
+
+	return ro.Map(func(item T) []string {
+		return encodeRow(reflect.ValueOf(item), columns)
+	})
+}
+
+// HeaderRow returns the column names derived from T's struct tags, in the
+// same order as the rows produced by EncodeRows. It is meant to be prepended
+// to the stream, e.g. with ro.StartWith.
+func HeaderRow[T any]() []string {
+	columns := mustSchemaOf[T](false)
+
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.name
+	}
+
+	return header
+}
+
+func mustSchemaOf[T any](strict bool) []column {
+	var t T
+
+	columns, err := schemaOf(reflect.TypeOf(t), strict)
+	if err != nil {
+		panic(err)
+	}
+
+	return columns
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package rocsvmap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	City    string `csv:"city"`
+	Country string `csv:"country"`
+}
+
+type user struct {
+	ID        string    `csv:"id"`
+	Name      string    `csv:"name"`
+	Age       int       `csv:"age"`
+	Address   address   `csv:"address"`
+	CreatedAt time.Time `csv:"created_at,format=2006-01-02"`
+	Ignored   string    `csv:"-"`
+	Untagged  bool
+}
+
+func TestEncodeRows(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	rows, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(
+				user{
+					ID:        "1",
+					Name:      "Alice",
+					Age:       30,
+					Address:   address{City: "Paris", Country: "FR"},
+					CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+					Ignored:   "nope",
+					Untagged:  true,
+				},
+			),
+			EncodeRows[user](),
+		),
+	)
+	is.NoError(err)
+	is.Equal([][]string{
+		{"1", "Alice", "30", "Paris", "FR", "2025-01-02", "true"},
+	}, rows)
+}
+
+func TestEncodeRowsPointerFields(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type item struct {
+		Name  string `csv:"name"`
+		Count *int   `csv:"count"`
+	}
+
+	count := 42
+
+	rows, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(
+				item{Name: "a", Count: &count},
+				item{Name: "b"},
+			),
+			EncodeRows[item](),
+		),
+	)
+	is.NoError(err)
+	is.Equal([][]string{
+		{"a", "42"},
+		{"b", ""},
+	}, rows)
+}
+
+func TestEncodeRowsStrict(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type valid struct {
+		Name string `csv:"name"`
+	}
+
+	type unsupported struct {
+		Name  string         `csv:"name"`
+		Attrs map[string]int `csv:"attrs"`
+	}
+
+	type unexported struct {
+		Name string `csv:"name"`
+		note string `csv:"note"` //nolint:unused
+	}
+
+	is.NotPanics(func() {
+		EncodeRowsStrict[valid]()
+	})
+
+	is.Panics(func() {
+		EncodeRowsStrict[unsupported]()
+	})
+
+	is.Panics(func() {
+		EncodeRowsStrict[unexported]()
+	})
+
+	// lenient mode skips the broken fields instead
+	rows, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(unsupported{Name: "a", Attrs: map[string]int{"x": 1}}),
+			EncodeRows[unsupported](),
+		),
+	)
+	is.NoError(err)
+	is.Equal([][]string{{"a"}}, rows)
+}
+
+func TestEncodeRowsNotAStruct(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Panics(func() {
+		EncodeRows[int]()
+	})
+}
+
+func TestHeaderRow(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal(
+		[]string{"id", "name", "age", "address.city", "address.country", "created_at", "Untagged"},
+		HeaderRow[user](),
+	)
+
+	rows, err := ro.Collect(
+		ro.Pipe2(
+			ro.Just(user{ID: "1", Name: "Alice"}),
+			EncodeRows[user](),
+			ro.StartWith(HeaderRow[user]()),
+		),
+	)
+	is.NoError(err)
+	is.Len(rows, 2)
+	is.Equal(HeaderRow[user](), rows[0])
+}
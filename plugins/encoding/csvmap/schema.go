@@ -0,0 +1,222 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package rocsvmap
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// column describes one CSV column derived from a struct field.
+type column struct {
+	name   string
+	index  []int  // field index path, for nested structs
+	format string // time layout, for time.Time fields
+}
+
+// schemaOf walks the struct type and derives the column list, in field
+// declaration order. Nested structs are flattened with dotted names. In strict
+// mode, a tagged field that is unexported or of an unsupported type is an
+// error; otherwise it is silently skipped.
+func schemaOf(t reflect.Type, strict bool) ([]column, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("rocsvmap: %s is not a struct", t)
+	}
+
+	return schemaOfStruct(t, "", nil, strict)
+}
+
+func schemaOfStruct(t reflect.Type, prefix string, index []int, strict bool) ([]column, error) {
+	columns := []column{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name, format, skip := parseTag(field)
+		if skip {
+			continue
+		}
+
+		if field.PkgPath != "" { // unexported
+			if strict && hasTag(field) {
+				return nil, fmt.Errorf("rocsvmap: field %s.%s is unexported but tagged", t, field.Name)
+			}
+
+			continue
+		}
+
+		fieldIndex := append(append([]int{}, index...), i)
+		fieldType := derefType(field.Type)
+
+		if fieldType.Kind() == reflect.Struct && fieldType != timeType {
+			nested, err := schemaOfStruct(fieldType, prefix+name+".", fieldIndex, strict)
+			if err != nil {
+				return nil, err
+			}
+
+			columns = append(columns, nested...)
+
+			continue
+		}
+
+		if !isSupportedType(fieldType) {
+			if strict {
+				return nil, fmt.Errorf("rocsvmap: field %s.%s has unsupported type %s", t, field.Name, field.Type)
+			}
+
+			continue
+		}
+
+		columns = append(columns, column{
+			name:   prefix + name,
+			index:  fieldIndex,
+			format: format,
+		})
+	}
+
+	return columns, nil
+}
+
+func hasTag(field reflect.StructField) bool {
+	_, ok := field.Tag.Lookup("csv")
+	return ok
+}
+
+// parseTag extracts the column name and options from the `csv:"..."` tag.
+// Supported forms: `csv:"-"`, `csv:"name"`, `csv:"name,format=2006-01-02"`.
+func parseTag(field reflect.StructField) (name string, format string, skip bool) {
+	name = field.Name
+
+	tag, ok := field.Tag.Lookup("csv")
+	if !ok {
+		return name, "", false
+	}
+
+	if tag == "-" {
+		return "", "", true
+	}
+
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		name = parts[0]
+	}
+
+	for _, part := range parts[1:] {
+		if strings.HasPrefix(part, "format=") {
+			format = strings.TrimPrefix(part, "format=")
+		}
+	}
+
+	return name, format, false
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+func isSupportedType(t reflect.Type) bool {
+	if t == timeType {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// encodeRow renders one struct value into its CSV row, following the schema.
+func encodeRow(v reflect.Value, columns []column) []string {
+	row := make([]string, len(columns))
+
+	for i, col := range columns {
+		row[i] = renderValue(fieldByIndex(v, col.index), col.format)
+	}
+
+	return row
+}
+
+// fieldByIndex is like reflect.Value.FieldByIndex, but stops at nil pointers
+// instead of panicking.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(i)
+	}
+
+	return v
+}
+
+func renderValue(v reflect.Value, format string) string {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return ""
+	}
+
+	if v.Type() == timeType {
+		t, _ := v.Interface().(time.Time)
+		if format == "" {
+			format = time.RFC3339
+		}
+
+		return t.Format(format)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
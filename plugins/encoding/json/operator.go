@@ -29,6 +29,19 @@ func Marshal[T any]() func(ro.Observable[T]) ro.Observable[[]byte] {
 	})
 }
 
+// MarshalLines encodes values to newline-delimited JSON (JSON Lines). Each
+// emitted chunk is one marshaled value followed by '\n', ready to be written
+// as-is by a byte sink.
+func MarshalLines[T any]() func(ro.Observable[T]) ro.Observable[[]byte] {
+	return ro.MapErr(func(v T) ([]byte, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return append(b, '\n'), nil
+	})
+}
+
 // Unmarshal decodes JSON data to values.
 // Play: https://go.dev/play/p/8hN7m8kK4jT
 func Unmarshal[T any]() func(ro.Observable[[]byte]) ro.Observable[T] {
@@ -191,6 +191,44 @@ func TestMarshal(t *testing.T) {
 	})
 }
 
+func TestMarshalLines(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	t.Run("struct marshaling", func(t *testing.T) {
+		input := []testStruct{
+			{Name: "Alice", Age: 30},
+			{Name: "Bob", Age: 25},
+		}
+		expected := [][]byte{
+			[]byte(`{"name":"Alice","age":30}` + "\n"),
+			[]byte(`{"name":"Bob","age":25}` + "\n"),
+		}
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.FromSlice(input),
+				MarshalLines[testStruct](),
+			),
+		)
+
+		is.Equal(expected, values)
+		is.Nil(err)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[testStruct](),
+				MarshalLines[testStruct](),
+			),
+		)
+
+		is.Equal([][]byte{}, values)
+		is.Nil(err)
+	})
+}
+
 func TestUnmarshal(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
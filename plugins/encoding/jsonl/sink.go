@@ -0,0 +1,67 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rojsonl
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/samber/ro"
+)
+
+// NewJSONLinesWriter encodes each value as a single JSON line (ndjson) to a
+// writer, forwarding the values downstream unchanged. The output is buffered
+// and flushed on completion or error. An encoding failure stops the stream
+// with an error.
+func NewJSONLinesWriter[T any](writer io.Writer) func(ro.Observable[T]) ro.Observable[T] {
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			buffered := bufio.NewWriter(writer)
+			encoder := json.NewEncoder(buffered)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						// Encode terminates each value with a newline
+						err := encoder.Encode(value)
+						if err != nil {
+							_ = buffered.Flush()
+							destination.ErrorWithContext(ctx, err)
+						} else {
+							destination.NextWithContext(ctx, value)
+						}
+					},
+					func(ctx context.Context, err error) {
+						_ = buffered.Flush()
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						err := buffered.Flush()
+						if err != nil {
+							destination.ErrorWithContext(ctx, err)
+						} else {
+							destination.CompleteWithContext(ctx)
+						}
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rojsonl
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJSONLinesWriter(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	buf := bytes.Buffer{}
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(
+				user{Name: "Alice", Age: 30},
+				user{Name: "Bob", Age: 25},
+			),
+			NewJSONLinesWriter[user](&buf),
+		),
+	)
+	is.Equal([]user{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+	}, values)
+	is.Nil(err)
+	is.Equal(`{"name":"Alice","age":30}
+{"name":"Bob","age":25}
+`, buf.String())
+
+	// upstream errors are forwarded after flushing
+	buf.Reset()
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[user](assert.AnError),
+			NewJSONLinesWriter[user](&buf),
+		),
+	)
+	is.Equal([]user{}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.Equal("", buf.String())
+
+	// an unencodable value stops the stream with an error
+	buf.Reset()
+
+	chans, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(make(chan int)),
+			NewJSONLinesWriter[chan int](&buf),
+		),
+	)
+	is.Equal([]chan int{}, chans)
+	is.Error(err)
+}
+
+func TestJSONLinesRoundtrip(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := `{"name":"Alice","age":30}
+{"name":"Bob","age":25}
+`
+
+	buf := bytes.Buffer{}
+
+	_, err := ro.Collect(
+		ro.Pipe1(
+			NewJSONLinesReader[user](strings.NewReader(input)),
+			NewJSONLinesWriter[user](&buf),
+		),
+	)
+	is.Nil(err)
+	is.Equal(input, buf.String())
+}
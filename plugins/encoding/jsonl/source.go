@@ -0,0 +1,112 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rojsonl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/samber/ro"
+)
+
+// NewJSONLinesReader creates an observable that decodes one JSON value per
+// line (ndjson) from a reader into T. Blank lines are skipped. A line that
+// fails to decode stops the stream with an error.
+func NewJSONLinesReader[T any](reader io.Reader) ro.Observable[T] {
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[T]) ro.Teardown {
+		scanner := bufio.NewScanner(reader)
+
+		for scanner.Scan() {
+			line := bytes.TrimSpace(scanner.Bytes())
+			if len(line) == 0 {
+				continue
+			}
+
+			var value T
+
+			err := json.Unmarshal(line, &value)
+			if err != nil {
+				destination.ErrorWithContext(ctx, err)
+				return nil
+			}
+
+			destination.NextWithContext(ctx, value)
+		}
+
+		err := scanner.Err()
+		if err != nil {
+			destination.ErrorWithContext(ctx, err)
+		} else {
+			destination.CompleteWithContext(ctx)
+		}
+
+		return nil
+	})
+}
+
+// NewJSONArrayReader creates an observable that streams the elements of a
+// top-level JSON array from a reader into T, decoding one element at a time
+// so gigabyte-scale arrays don't need to be fully buffered. Each element is
+// emitted as it is decoded and the stream completes after the closing
+// bracket. Malformed JSON stops the stream with an error carrying the input
+// offset.
+func NewJSONArrayReader[T any](reader io.Reader) ro.Observable[T] {
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[T]) ro.Teardown {
+		decoder := json.NewDecoder(reader)
+
+		fail := func(err error) {
+			destination.ErrorWithContext(ctx, fmt.Errorf("rojsonl.NewJSONArrayReader: at offset %d: %w", decoder.InputOffset(), err))
+		}
+
+		token, err := decoder.Token()
+		if err != nil {
+			fail(err)
+			return nil
+		}
+
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			fail(errors.New("expected a top-level JSON array"))
+			return nil
+		}
+
+		for decoder.More() {
+			var value T
+
+			err := decoder.Decode(&value)
+			if err != nil {
+				fail(err)
+				return nil
+			}
+
+			destination.NextWithContext(ctx, value)
+		}
+
+		// consume the closing bracket
+		_, err = decoder.Token()
+		if err != nil {
+			fail(err)
+			return nil
+		}
+
+		destination.CompleteWithContext(ctx)
+
+		return nil
+	})
+}
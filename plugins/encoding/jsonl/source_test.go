@@ -0,0 +1,111 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rojsonl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func TestNewJSONLinesReader(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := strings.NewReader(`{"name":"Alice","age":30}
+{"name":"Bob","age":25}
+
+{"name":"Charlie","age":35}
+`)
+
+	values, err := ro.Collect(
+		NewJSONLinesReader[user](reader),
+	)
+	is.Equal([]user{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Charlie", Age: 35},
+	}, values)
+	is.Nil(err)
+
+	// empty input
+	values, err = ro.Collect(
+		NewJSONLinesReader[user](strings.NewReader("")),
+	)
+	is.Equal([]user{}, values)
+	is.Nil(err)
+
+	// a malformed line stops the stream with an error
+	values, err = ro.Collect(
+		NewJSONLinesReader[user](strings.NewReader(`{"name":"Alice","age":30}
+{"name":`)),
+	)
+	is.Equal([]user{{Name: "Alice", Age: 30}}, values)
+	is.Error(err)
+}
+
+func TestNewJSONArrayReader(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := strings.NewReader(`[
+		{"name":"Alice","age":30},
+		{"name":"Bob","age":25}
+	]`)
+
+	values, err := ro.Collect(
+		NewJSONArrayReader[user](reader),
+	)
+	is.Equal([]user{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+	}, values)
+	is.Nil(err)
+
+	// empty array
+	values, err = ro.Collect(
+		NewJSONArrayReader[user](strings.NewReader(`[]`)),
+	)
+	is.Equal([]user{}, values)
+	is.Nil(err)
+
+	// a top-level object is rejected
+	values, err = ro.Collect(
+		NewJSONArrayReader[user](strings.NewReader(`{"name":"Alice"}`)),
+	)
+	is.Equal([]user{}, values)
+	is.ErrorContains(err, "expected a top-level JSON array")
+
+	// malformed JSON mid-stream reports the offset
+	values, err = ro.Collect(
+		NewJSONArrayReader[user](strings.NewReader(`[{"name":"Alice","age":30},{"name":]`)),
+	)
+	is.Equal([]user{{Name: "Alice", Age: 30}}, values)
+	is.ErrorContains(err, "at offset")
+
+	// truncated input never reaches the closing bracket
+	values, err = ro.Collect(
+		NewJSONArrayReader[user](strings.NewReader(`[{"name":"Alice","age":30}`)),
+	)
+	is.Equal([]user{{Name: "Alice", Age: 30}}, values)
+	is.Error(err)
+}
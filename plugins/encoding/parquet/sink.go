@@ -0,0 +1,70 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roparquet
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/samber/ro"
+)
+
+// NewParquetWriter encodes each value as a Parquet row to a writer, forwarding
+// the values downstream unchanged. Rows are buffered and flushed as row groups
+// by the underlying writer; the file footer is written on completion. A row
+// that fails to encode stops the stream with an error.
+func NewParquetWriter[T any](writer io.Writer, options ...parquet.WriterOption) func(ro.Observable[T]) ro.Observable[T] {
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			encoder := parquet.NewGenericWriter[T](writer, options...)
+
+			fail := func(ctx context.Context, err error) {
+				destination.ErrorWithContext(ctx, fmt.Errorf("roparquet.NewParquetWriter: %w", err))
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						_, err := encoder.Write([]T{value})
+						if err != nil {
+							_ = encoder.Close()
+							fail(ctx, err)
+						} else {
+							destination.NextWithContext(ctx, value)
+						}
+					},
+					func(ctx context.Context, err error) {
+						_ = encoder.Close()
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						// Close flushes the last row group and writes the footer
+						err := encoder.Close()
+						if err != nil {
+							fail(ctx, err)
+						} else {
+							destination.CompleteWithContext(ctx)
+						}
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roparquet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewParquetWriter(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buffer bytes.Buffer
+
+	// values are forwarded downstream unchanged
+	values, err := ro.Collect(
+		NewParquetWriter[user](&buffer)(
+			ro.Just(
+				user{Name: "Alice", Age: 30},
+				user{Name: "Bob", Age: 25},
+			),
+		),
+	)
+	is.Equal([]user{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+	}, values)
+	is.Nil(err)
+	is.NotEmpty(buffer.Bytes())
+
+	// upstream errors are forwarded after closing the writer
+	buffer.Reset()
+
+	values, err = ro.Collect(
+		NewParquetWriter[user](&buffer)(
+			ro.Throw[user](assert.AnError),
+		),
+	)
+	is.Equal([]user{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestParquetRoundtrip(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buffer bytes.Buffer
+
+	input := []user{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Charlie", Age: 35},
+	}
+
+	_, err := ro.Collect(
+		NewParquetWriter[user](&buffer)(
+			ro.FromSlice(input),
+		),
+	)
+	is.Nil(err)
+
+	values, err := ro.Collect(
+		NewParquetReader[user](bytes.NewReader(buffer.Bytes()), int64(buffer.Len())),
+	)
+	is.Equal(input, values)
+	is.Nil(err)
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roparquet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/samber/ro"
+)
+
+// readBatchSize is the number of rows decoded per call into the underlying
+// reader. Row groups themselves are loaded lazily by parquet-go.
+const readBatchSize = 128
+
+// NewParquetReader creates an observable that streams the rows of a Parquet
+// file into T, one struct per row, using `parquet:"..."` struct tags for the
+// column mapping. Row groups are read lazily, so files larger than memory can
+// be consumed. The stream completes at the end of the file and errors on a
+// corrupt footer or row group.
+func NewParquetReader[T any](r io.ReaderAt, size int64) ro.Observable[T] {
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[T]) ro.Teardown {
+		fail := func(err error) {
+			destination.ErrorWithContext(ctx, fmt.Errorf("roparquet.NewParquetReader: %w", err))
+		}
+
+		file, err := parquet.OpenFile(r, size)
+		if err != nil {
+			fail(err)
+			return nil
+		}
+
+		reader := parquet.NewGenericReader[T](file)
+		defer reader.Close()
+
+		rows := make([]T, readBatchSize)
+
+		for {
+			n, err := reader.Read(rows)
+
+			for i := 0; i < n; i++ {
+				destination.NextWithContext(ctx, rows[i])
+			}
+
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					destination.CompleteWithContext(ctx)
+				} else {
+					fail(err)
+				}
+
+				return nil
+			}
+		}
+	})
+}
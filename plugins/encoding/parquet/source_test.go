@@ -0,0 +1,80 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roparquet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	Name string `parquet:"name"`
+	Age  int64  `parquet:"age"`
+}
+
+func encodeUsers(t *testing.T, users []user) []byte {
+	t.Helper()
+
+	var buffer bytes.Buffer
+
+	writer := parquet.NewGenericWriter[user](&buffer)
+
+	_, err := writer.Write(users)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	return buffer.Bytes()
+}
+
+func TestNewParquetReader(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data := encodeUsers(t, []user{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Charlie", Age: 35},
+	})
+
+	values, err := ro.Collect(
+		NewParquetReader[user](bytes.NewReader(data), int64(len(data))),
+	)
+	is.Equal([]user{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Charlie", Age: 35},
+	}, values)
+	is.Nil(err)
+
+	// empty file
+	data = encodeUsers(t, []user{})
+
+	values, err = ro.Collect(
+		NewParquetReader[user](bytes.NewReader(data), int64(len(data))),
+	)
+	is.Equal([]user{}, values)
+	is.Nil(err)
+
+	// a corrupt footer stops the stream with an error
+	values, err = ro.Collect(
+		NewParquetReader[user](bytes.NewReader([]byte("not a parquet file")), 18),
+	)
+	is.Equal([]user{}, values)
+	is.ErrorContains(err, "roparquet.NewParquetReader")
+}
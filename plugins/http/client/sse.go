@@ -0,0 +1,169 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rohttpclient
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// sseDefaultRetry is the reconnection delay used until the server sends a
+// `retry:` field.
+const sseDefaultRetry = 3 * time.Second
+
+// SSEEvent is a single Server-Sent Events message.
+type SSEEvent struct {
+	// ID is the last event id seen on the stream. It is replayed to the
+	// server in the Last-Event-ID header on reconnection.
+	ID string
+	// Event is the event type, defaulting to "message".
+	Event string
+	// Data is the event payload. Multiple data lines are joined with "\n".
+	Data string
+}
+
+// NewSSEObservable connects to a Server-Sent Events endpoint and emits one
+// SSEEvent per message. When the connection drops, it reconnects after the
+// server-advertised retry delay with the Last-Event-ID header set to the last
+// seen event id, so the server can resume the feed. The observable completes
+// when ctx is canceled or when the server replies with 204 No Content, and
+// errors on any other non-200 status code.
+func NewSSEObservable(ctx context.Context, url string) ro.Observable[SSEEvent] {
+	return ro.NewObservable(func(destination ro.Observer[SSEEvent]) ro.Teardown {
+		ctx, cancel := context.WithCancel(ctx)
+
+		go func() {
+			lastEventID := ""
+			retry := sseDefaultRetry
+
+			for {
+				done, err := sseStream(ctx, url, &lastEventID, &retry, destination)
+				if ctx.Err() != nil {
+					destination.CompleteWithContext(ctx)
+					return
+				}
+
+				if err != nil {
+					destination.ErrorWithContext(ctx, err)
+					return
+				}
+
+				if done {
+					destination.CompleteWithContext(ctx)
+					return
+				}
+
+				// transient failure: reconnect with Last-Event-ID
+				select {
+				case <-ctx.Done():
+					destination.CompleteWithContext(ctx)
+					return
+				case <-time.After(retry):
+				}
+			}
+		}()
+
+		return (func())(cancel)
+	})
+}
+
+// sseStream opens a single connection to the endpoint and forwards messages
+// until the stream ends. It returns done=true when the server asks not to
+// reconnect (204 No Content), an error on permanent failures, and
+// (false, nil) on transient ones.
+func sseStream(ctx context.Context, url string, lastEventID *string, retry *time.Duration, destination ro.Observer[SSEEvent]) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	if *lastEventID != "" {
+		req.Header.Set("Last-Event-ID", *lastEventID)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		// connection failures are transient, the caller reconnects
+		return false, nil
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNoContent {
+		return true, nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("rohttpclient.NewSSEObservable: unexpected status code %d", res.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(res.Body)
+	event := SSEEvent{}
+	dataLines := []string{}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case line == "":
+			// a blank line dispatches the pending message
+			if len(dataLines) > 0 {
+				event.ID = *lastEventID
+				event.Data = strings.Join(dataLines, "\n")
+				if event.Event == "" {
+					event.Event = "message"
+				}
+
+				destination.Next(event)
+			}
+
+			event = SSEEvent{}
+			dataLines = nil
+
+		case strings.HasPrefix(line, ":"):
+			// comment, used by servers as keep-alive
+
+		default:
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+
+			switch field {
+			case "event":
+				event.Event = value
+			case "data":
+				dataLines = append(dataLines, value)
+			case "id":
+				if !strings.Contains(value, "\x00") {
+					*lastEventID = value
+				}
+			case "retry":
+				if ms, err := strconv.Atoi(value); err == nil && ms >= 0 {
+					*retry = time.Duration(ms) * time.Millisecond
+				}
+			}
+		}
+	}
+
+	// the connection dropped or the server closed the stream: transient
+	return false, nil
+}
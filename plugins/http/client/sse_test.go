@@ -0,0 +1,151 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rohttpclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSSEObservable(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintf(w, "retry: 10\n\n")
+		fmt.Fprintf(w, ": keep-alive\n\n")
+		fmt.Fprintf(w, "id: 1\ndata: hello\n\n")
+		fmt.Fprintf(w, "event: update\ndata: line1\ndata: line2\n\n")
+	}))
+	defer server.Close()
+
+	count := int64(0)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			NewSSEObservable(context.Background(), server.URL),
+			ro.TakeWhile(func(event SSEEvent) bool {
+				// the stream reconnects forever, stop after the first connection
+				return atomic.AddInt64(&count, 1) <= 2
+			}),
+		),
+	)
+	is.Len(values, 2)
+	is.Equal(SSEEvent{ID: "1", Event: "message", Data: "hello"}, values[0])
+	is.Equal(SSEEvent{ID: "1", Event: "update", Data: "line1\nline2"}, values[1])
+	is.Nil(err)
+
+	http.DefaultClient.CloseIdleConnections()
+}
+
+func TestNewSSEObservable_Reconnect(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	mu := sync.Mutex{}
+	connections := int64(0)
+	lastEventIDs := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt64(&connections, 1)
+		mu.Lock()
+		lastEventIDs = append(lastEventIDs, r.Header.Get("Last-Event-ID"))
+		mu.Unlock()
+
+		if n >= 3 {
+			// asks the client to stop reconnecting
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintf(w, "retry: 10\nid: %d\ndata: message %d\n\n", n, n)
+	}))
+	defer server.Close()
+
+	values, err := ro.Collect(
+		NewSSEObservable(context.Background(), server.URL),
+	)
+	is.Len(values, 2)
+	is.Equal(SSEEvent{ID: "1", Event: "message", Data: "message 1"}, values[0])
+	is.Equal(SSEEvent{ID: "2", Event: "message", Data: "message 2"}, values[1])
+	is.Equal([]string{"", "1", "2"}, lastEventIDs)
+	is.Nil(err)
+
+	http.DefaultClient.CloseIdleConnections()
+}
+
+func TestNewSSEObservable_Cancellation(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintf(w, "data: first\n\n")
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			NewSSEObservable(ctx, server.URL),
+			ro.Tap(
+				func(event SSEEvent) {
+					// cancel mid-stream: the observable completes cleanly
+					cancel()
+				},
+				func(err error) {},
+				func() {},
+			),
+		),
+	)
+	is.Len(values, 1)
+	is.Equal(SSEEvent{ID: "", Event: "message", Data: "first"}, values[0])
+	is.Nil(err)
+
+	http.DefaultClient.CloseIdleConnections()
+}
+
+func TestNewSSEObservable_HTTPError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	values, err := ro.Collect(
+		NewSSEObservable(context.Background(), server.URL),
+	)
+	is.Equal([]SSEEvent{}, values)
+	is.EqualError(err, "rohttpclient.NewSSEObservable: unexpected status code 500")
+
+	http.DefaultClient.CloseIdleConnections()
+}
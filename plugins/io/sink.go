@@ -94,3 +94,53 @@ func NewStdWriter() func(ro.Observable[[]byte]) ro.Observable[int] {
 		})
 	}
 }
+
+// NewWriter creates a pass-through sink that encodes each item and writes it to
+// an io.Writer, forwarding the original item downstream. Encode and write
+// failures are emitted as error notifications. On completion, the writer is
+// flushed when it exposes a Flush method and closed when it implements
+// io.Closer.
+func NewWriter[T any](writer io.Writer, encode func(item T) ([]byte, error)) func(ro.Observable[T]) ro.Observable[T] {
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			finalize := func() {
+				if flusher, ok := writer.(interface{ Flush() error }); ok {
+					_ = flusher.Flush()
+				} else if flusher, ok := writer.(interface{ Flush() }); ok {
+					flusher.Flush()
+				}
+
+				if closer, ok := writer.(io.Closer); ok {
+					_ = closer.Close()
+				}
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						output, err := encode(value)
+						if err != nil {
+							destination.ErrorWithContext(ctx, err)
+							return
+						}
+
+						if _, err := writer.Write(output); err != nil {
+							destination.ErrorWithContext(ctx, err)
+							return
+						}
+
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						finalize()
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
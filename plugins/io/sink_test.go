@@ -300,3 +300,82 @@ type errorWriter struct {
 func (w *errorWriter) Write(p []byte) (n int, err error) {
 	return 0, w.err
 }
+
+type flushCloseBuffer struct {
+	bytes.Buffer
+	flushed int
+	closed  int
+}
+
+func (b *flushCloseBuffer) Flush() error { b.flushed++; return nil }
+func (b *flushCloseBuffer) Close() error { b.closed++; return nil }
+
+func TestNewWriter(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	encode := func(item string) ([]byte, error) {
+		return []byte(item + "\n"), nil
+	}
+
+	buf := &flushCloseBuffer{}
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("a", "b"),
+			NewWriter(buf, encode),
+		),
+	)
+	is.Equal([]string{"a", "b"}, values)
+	is.Nil(err)
+	is.Equal("a\nb\n", buf.String())
+	is.Equal(1, buf.flushed)
+	is.Equal(1, buf.closed)
+}
+
+func TestNewWriter_EncodeError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buf bytes.Buffer
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("a", "b"),
+			NewWriter(&buf, func(item string) ([]byte, error) {
+				if item == "b" {
+					return nil, errors.New("encode error")
+				}
+
+				return []byte(item), nil
+			}),
+		),
+	)
+	is.Equal([]string{"a"}, values)
+	is.NotNil(err)
+	is.Equal("encode error", err.Error())
+	is.Equal("a", buf.String())
+}
+
+type failingWriter struct{}
+
+func (w *failingWriter) Write(p []byte) (n int, err error) {
+	return 0, errors.New("write error")
+}
+
+func TestNewWriter_WriteError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("a"),
+			NewWriter(&failingWriter{}, func(item string) ([]byte, error) {
+				return []byte(item), nil
+			}),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.NotNil(err)
+	is.Equal("write error", err.Error())
+}
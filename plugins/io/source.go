@@ -120,3 +120,111 @@ func NewPrompt(prompt string) ro.Observable[[]byte] {
 		return func() {}
 	})
 }
+
+// NewLineReader creates an observable that reads lines from an io.Reader and
+// emits them as strings. Reading starts lazily on subscription, a read failure
+// other than io.EOF is emitted as an error, and the observable completes on
+// io.EOF. The reader is closed on teardown when it implements io.Closer.
+func NewLineReader(reader io.Reader) ro.Observable[string] {
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[string]) ro.Teardown {
+		r := bufio.NewReader(reader)
+
+		for !destination.IsClosed() {
+			line, _, err := r.ReadLine()
+			if err != nil {
+				if err == io.EOF {
+					destination.CompleteWithContext(ctx)
+				} else {
+					destination.ErrorWithContext(ctx, err)
+				}
+				break
+			}
+
+			destination.NextWithContext(ctx, string(line))
+		}
+
+		return func() {
+			if closer, ok := reader.(io.Closer); ok {
+				closer.Close()
+			}
+		}
+	})
+}
+
+// NewScannerReader creates an observable that emits one []byte token per line
+// from an io.Reader. A custom bufio.SplitFunc may be provided to scan words,
+// runes or any other token shape; a nil split defaults to bufio.ScanLines.
+// Reading starts lazily on subscription, a read failure other than io.EOF is
+// emitted as an error, and the observable completes on io.EOF. The reader is
+// closed on teardown when it implements io.Closer.
+func NewScannerReader(reader io.Reader, split bufio.SplitFunc) ro.Observable[[]byte] {
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[[]byte]) ro.Teardown {
+		scanner := bufio.NewScanner(reader)
+		if split != nil {
+			scanner.Split(split)
+		}
+
+		for !destination.IsClosed() && scanner.Scan() {
+			token := scanner.Bytes()
+			output := make([]byte, len(token))
+			copy(output, token)
+			destination.NextWithContext(ctx, output)
+		}
+
+		if err := scanner.Err(); err != nil {
+			destination.ErrorWithContext(ctx, err)
+		} else {
+			destination.CompleteWithContext(ctx)
+		}
+
+		return func() {
+			if closer, ok := reader.(io.Closer); ok {
+				closer.Close()
+			}
+		}
+	})
+}
+
+// NewReaderChunks is an alias to NewChunkReader.
+func NewReaderChunks(reader io.Reader, chunkSize int) ro.Observable[[]byte] {
+	return NewChunkReader(reader, chunkSize)
+}
+
+// NewChunkReader creates an observable that reads fixed-size chunks from an
+// io.Reader. Every chunk is `size` bytes long, except possibly the last one.
+// Reading starts lazily on subscription, a read failure other than io.EOF is
+// emitted as an error, and the observable completes on io.EOF. The reader is
+// closed on teardown when it implements io.Closer.
+func NewChunkReader(reader io.Reader, size int) ro.Observable[[]byte] {
+	if size < 1 {
+		panic("roio.NewChunkReader: Size parameter must be greater than 0")
+	}
+
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[[]byte]) ro.Teardown {
+		buf := make([]byte, size)
+
+		for !destination.IsClosed() {
+			n, err := io.ReadFull(reader, buf)
+			if n > 0 {
+				chunk := make([]byte, n)
+				copy(chunk, buf[:n])
+				destination.NextWithContext(ctx, chunk)
+			}
+
+			if err != nil {
+				if err == io.EOF || err == io.ErrUnexpectedEOF {
+					destination.CompleteWithContext(ctx)
+				} else {
+					destination.ErrorWithContext(ctx, err)
+				}
+				break
+			}
+		}
+
+		return func() {
+			if closer, ok := reader.(io.Closer); ok {
+				closer.Close()
+			}
+		}
+	})
+}
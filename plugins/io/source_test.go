@@ -12,10 +12,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-
 package roio
 
 import (
+	"bufio"
 	"errors"
 	"strings"
 	"testing"
@@ -130,3 +130,91 @@ func TestNewIOReader_Closer(t *testing.T) {
 
 	is.True(reader.closed)
 }
+
+func TestNewLineReader(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := strings.NewReader("Hello,\nWorld!\n")
+
+	values, err := ro.Collect(NewLineReader(reader))
+	is.Equal([]string{"Hello,", "World!"}, values)
+	is.Nil(err)
+}
+
+func TestNewLineReader_Error(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := &mockReader{}
+
+	_, err := ro.Collect(NewLineReader(reader))
+	is.NotNil(err)
+	is.Equal("mock error", err.Error())
+}
+
+func TestNewScannerReader(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := strings.NewReader("Hello,\nWorld!\n")
+
+	values, err := ro.Collect(NewScannerReader(reader, nil))
+	is.Equal([][]byte{[]byte("Hello,"), []byte("World!")}, values)
+	is.Nil(err)
+
+	// a custom split function scans other token shapes
+	reader = strings.NewReader("Hello, World!")
+
+	values, err = ro.Collect(NewScannerReader(reader, bufio.ScanWords))
+	is.Equal([][]byte{[]byte("Hello,"), []byte("World!")}, values)
+	is.Nil(err)
+}
+
+func TestNewScannerReader_Error(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := &mockReader{}
+
+	_, err := ro.Collect(NewScannerReader(reader, nil))
+	is.NotNil(err)
+	is.Equal("mock error", err.Error())
+}
+
+func TestNewChunkReader(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := strings.NewReader("Hello, World!")
+
+	values, err := ro.Collect(NewChunkReader(reader, 5))
+	is.Equal([][]byte{[]byte("Hello"), []byte(", Wor"), []byte("ld!")}, values)
+	is.Nil(err)
+
+	is.Panics(func() {
+		NewChunkReader(strings.NewReader(""), 0)
+	})
+}
+
+func TestNewReaderChunks(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := strings.NewReader("Hello, World!")
+
+	values, err := ro.Collect(NewReaderChunks(reader, 5))
+	is.Equal([][]byte{[]byte("Hello"), []byte(", Wor"), []byte("ld!")}, values)
+	is.Nil(err)
+}
+
+func TestNewChunkReader_Error(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := &mockReader{}
+
+	_, err := ro.Collect(NewChunkReader(reader, 4))
+	is.NotNil(err)
+	is.Equal("mock error", err.Error())
+}
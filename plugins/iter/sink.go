@@ -15,104 +15,139 @@
 package roiter
 
 import (
-	"context"
 	"iter"
+	"sync"
 
 	"github.com/samber/ro"
 )
 
-// ToSeq converts an observable to a Go sequence iterator.
+// ToSeq converts an observable to a Go sequence iterator. The source is
+// subscribed when the range loop starts and items are delivered one by one:
+// the producer blocks until the loop body asks for the next item. Breaking
+// out of the loop unsubscribes from the source. An upstream error panics;
+// use ToSeqWithError to observe errors.
 func ToSeq[T any](source ro.Observable[T]) iter.Seq[T] {
 	return func(yield func(T) bool) {
-		// Create channels for synchronization
-		values := make(chan T, 1)
-		done := make(chan struct{})
-
-		// Create a context for cancellation
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-
-		// Subscribe to the observable
-		sub := source.SubscribeWithContext(
-			ctx,
-			ro.NewObserverWithContext(
-				func(ctx context.Context, value T) {
-					select {
-					case values <- value:
-					case <-ctx.Done():
-					}
-				},
-				func(ctx context.Context, err error) {
-					defer close(done)
-					panic(err)
-				},
-				func(ctx context.Context) {
-					close(done)
-				},
-			),
-		)
-
-		// Clean up subscription
-		defer sub.Unsubscribe()
-
-		// Yield values as they arrive
-		for {
-			select {
-			case value := <-values:
-				if !yield(value) {
+		for notification := range toNotificationSeq(source) {
+			switch notification.Kind {
+			case ro.KindNext:
+				if !yield(notification.Value) {
 					return
 				}
-			case <-done:
+			case ro.KindError:
+				panic(notification.Err)
+			case ro.KindComplete:
 				return
 			}
 		}
 	}
 }
 
-// ToSeq2 converts an observable to a Go sequence iterator with index-value pairs.
+// ToSeq2 converts an observable to a Go sequence iterator with index-value
+// pairs. The source is subscribed when the range loop starts and items are
+// delivered one by one. Breaking out of the loop unsubscribes from the
+// source. An upstream error panics; use ToSeqWithError to observe errors.
 func ToSeq2[T any](source ro.Observable[T]) iter.Seq2[int, T] {
 	return func(yield func(int, T) bool) {
-		// Create channels for synchronization
-		values := make(chan T, 1)
-		done := make(chan struct{})
-
-		// Create a context for cancellation
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-
-		// Subscribe to the observable
-		sub := source.SubscribeWithContext(
-			ctx,
-			ro.NewObserverWithContext(
-				func(ctx context.Context, value T) {
-					select {
-					case values <- value:
-					case <-ctx.Done():
-					}
-				},
-				func(ctx context.Context, err error) {
-					defer close(done)
-					panic(err)
-				},
-				func(ctx context.Context) {
-					close(done)
-				},
-			),
-		)
-
-		// Clean up subscription
-		defer sub.Unsubscribe()
-
-		// Yield key-value pairs as they arrive
 		i := 0
-		for {
-			select {
-			case value := <-values:
-				if !yield(i, value) {
+
+		for notification := range toNotificationSeq(source) {
+			switch notification.Kind {
+			case ro.KindNext:
+				if !yield(i, notification.Value) {
 					return
 				}
+
 				i++
+			case ro.KindError:
+				panic(notification.Err)
+			case ro.KindComplete:
+				return
+			}
+		}
+	}
+}
+
+// ToSeqWithError converts an observable to a Go sequence iterator of
+// (value, error) pairs. The observable is subscribed lazily on first
+// iteration and the subscription is disposed when the consumer breaks out of
+// the loop. Regular values are yielded with a nil error; an upstream error is
+// yielded as the final pair, with the zero value.
+func ToSeqWithError[T any](source ro.Observable[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for notification := range toNotificationSeq(source) {
+			switch notification.Kind {
+			case ro.KindNext:
+				if !yield(notification.Value, nil) {
+					return
+				}
+			case ro.KindError:
+				var zero T
+
+				yield(zero, notification.Err)
+
+				return
+			case ro.KindComplete:
+				return
+			}
+		}
+	}
+}
+
+// toNotificationSeq subscribes to the source on a dedicated goroutine (many
+// sources emit synchronously during Subscribe) and hands notifications over
+// an unbuffered channel, so the producer advances in lock-step with the
+// consumer.
+func toNotificationSeq[T any](source ro.Observable[T]) iter.Seq[ro.Notification[T]] {
+	return func(yield func(ro.Notification[T]) bool) {
+		ch := make(chan ro.Notification[T])
+		done := make(chan struct{})
+
+		once := sync.Once{}
+		stop := func() {
+			once.Do(func() {
+				close(done)
+			})
+		}
+
+		forward := func(notification ro.Notification[T]) {
+			select {
+			case ch <- notification:
 			case <-done:
+			}
+		}
+
+		subscriptions := ro.NewSubscription(nil)
+
+		go func() {
+			subscriptions.AddUnsubscribable(
+				source.Subscribe(
+					ro.NewObserver(
+						func(value T) {
+							forward(ro.NewNotificationNext(value))
+						},
+						func(err error) {
+							forward(ro.NewNotificationError[T](err))
+						},
+						func() {
+							forward(ro.NewNotificationComplete[T]())
+						},
+					),
+				),
+			)
+		}()
+
+		defer subscriptions.Unsubscribe()
+		defer stop()
+
+		for {
+			notification := <-ch
+
+			if !yield(notification) {
+				return
+			}
+
+			if notification.Kind != ro.KindNext {
 				return
 			}
 		}
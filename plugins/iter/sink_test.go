@@ -162,3 +162,75 @@ func TestToSeq2WithError(t *testing.T) {
 	assert.Equal(t, []int{1, 2}, keys)
 	assert.Equal(t, []string{"a", "b"}, values)
 }
+
+func TestToSeqWithErrorPairs(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Create an observable that emits values then an error
+	observable := ro.NewObservableWithContext(func(ctx context.Context, observer ro.Observer[int]) ro.Teardown {
+		observer.NextWithContext(ctx, 1)
+		observer.NextWithContext(ctx, 2)
+		observer.ErrorWithContext(ctx, assert.AnError)
+		return nil
+	})
+
+	// Collect pairs from the iterator
+	var values []int
+	var errs []error
+	for v, err := range ToSeqWithError(observable) {
+		values = append(values, v)
+		errs = append(errs, err)
+	}
+
+	// Verify that the error surfaced as the final pair
+	is.Equal([]int{1, 2, 0}, values)
+	is.Equal([]error{nil, nil, assert.AnError}, errs)
+
+	// Create an observable that completes normally
+	observable = ro.NewObservableWithContext(func(ctx context.Context, observer ro.Observer[int]) ro.Teardown {
+		observer.NextWithContext(ctx, 42)
+		observer.CompleteWithContext(ctx)
+		return nil
+	})
+
+	values = nil
+	for v, err := range ToSeqWithError(observable) {
+		is.Nil(err)
+		values = append(values, v)
+	}
+
+	is.Equal([]int{42}, values)
+}
+
+func TestToSeqWithErrorCancellation(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Create an observable that emits values until cancelled
+	observable := ro.NewObservableWithContext(func(ctx context.Context, observer ro.Observer[int]) ro.Teardown {
+		for i := 1; i <= 10; i++ {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				observer.NextWithContext(ctx, i)
+			}
+		}
+		observer.CompleteWithContext(ctx)
+		return nil
+	})
+
+	// Collect only the first 3 values
+	var values []int
+	for v, err := range ToSeqWithError(observable) {
+		is.Nil(err)
+		values = append(values, v)
+		if len(values) >= 3 {
+			break
+		}
+	}
+
+	// Verify that only 3 values were collected
+	is.Equal([]int{1, 2, 3}, values)
+}
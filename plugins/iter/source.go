@@ -23,26 +23,64 @@ import (
 	"github.com/samber/ro"
 )
 
-// FromSeq creates an observable from a Go sequence iterator.
+// FromSeq creates an observable from a Go sequence iterator. The iterator is
+// consumed on a dedicated goroutine and stops being pulled (the yield function
+// returns false) as soon as the subscriber unsubscribes, so a large or
+// infinite sequence can be capped with ro.Take.
 // Play: https://go.dev/play/p/Cq-cq_AR4Z6
 func FromSeq[T any](iterator iter.Seq[T]) ro.Observable[T] {
 	return ro.NewObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
-		for v := range iterator {
-			destination.NextWithContext(subscriberCtx, v)
+		done := make(chan struct{})
+
+		go func() {
+			defer destination.CompleteWithContext(subscriberCtx)
+
+			iterator(func(v T) bool {
+				select {
+				case <-done:
+					return false
+				case <-subscriberCtx.Done():
+					return false
+				default:
+					destination.NextWithContext(subscriberCtx, v)
+					return true
+				}
+			})
+		}()
+
+		return func() {
+			close(done)
 		}
-		destination.CompleteWithContext(subscriberCtx)
-		return nil
 	})
 }
 
-// FromSeq2 creates an observable from a Go sequence iterator with key-value pairs.
+// FromSeq2 creates an observable from a Go sequence iterator with key-value
+// pairs. The iterator is consumed on a dedicated goroutine and stops being
+// pulled (the yield function returns false) as soon as the subscriber
+// unsubscribes, so a large or infinite sequence can be capped with ro.Take.
 // Play: https://go.dev/play/p/d-SZxjCKm9N
 func FromSeq2[K, V any](iterator iter.Seq2[K, V]) ro.Observable[lo.Tuple2[K, V]] {
 	return ro.NewObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[lo.Tuple2[K, V]]) ro.Teardown {
-		for k, v := range iterator {
-			destination.NextWithContext(subscriberCtx, lo.T2(k, v))
+		done := make(chan struct{})
+
+		go func() {
+			defer destination.CompleteWithContext(subscriberCtx)
+
+			iterator(func(k K, v V) bool {
+				select {
+				case <-done:
+					return false
+				case <-subscriberCtx.Done():
+					return false
+				default:
+					destination.NextWithContext(subscriberCtx, lo.T2(k, v))
+					return true
+				}
+			})
+		}()
+
+		return func() {
+			close(done)
 		}
-		destination.CompleteWithContext(subscriberCtx)
-		return nil
 	})
 }
@@ -33,7 +33,7 @@ func ExampleFromSeq() {
 	observable := FromSeq[int](seq)
 
 	subscription := observable.Subscribe(ro.PrintObserver[int]())
-	defer subscription.Unsubscribe()
+	subscription.Wait() // Note: using .Wait() is not recommended.
 
 	// Output:
 	// Next: 1
@@ -66,7 +66,7 @@ func ExampleFromSeq2() {
 	observable := FromSeq2[string, int](seq)
 
 	subscription := observable.Subscribe(ro.PrintObserver[lo.Tuple2[string, int]]())
-	defer subscription.Unsubscribe()
+	subscription.Wait() // Note: using .Wait() is not recommended.
 
 	// Output:
 	// Next: {A:Alice B:30}
@@ -89,7 +89,7 @@ func ExampleFromSeq_withStrings() {
 	observable := FromSeq[string](seq)
 
 	subscription := observable.Subscribe(ro.PrintObserver[string]())
-	defer subscription.Unsubscribe()
+	subscription.Wait() // Note: using .Wait() is not recommended.
 
 	// Output:
 	// Next: Hello
@@ -125,7 +125,32 @@ func ExampleFromSeq_withEarlyTermination() {
 			},
 		),
 	)
-	defer subscription.Unsubscribe()
+	subscription.Wait() // Note: using .Wait() is not recommended.
 
 	// Output: Will emit 1, 2, 3, 4, 5 then complete
 }
+
+func ExampleFromSeq_withFilter() {
+	// Create an infinite sequence of integers
+	seq := func(yield func(int) bool) {
+		for i := 1; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	observable := ro.Pipe3(
+		FromSeq[int](seq),
+		ro.Filter(func(value int) bool { return value%2 == 0 }),
+		ro.Take[int](3),
+		ro.ToSlice[int](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[[]int]())
+	subscription.Wait() // Note: using .Wait() is not recommended.
+
+	// Output:
+	// Next: [2 4 6]
+	// Completed
+}
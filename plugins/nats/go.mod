@@ -0,0 +1,21 @@
+module github.com/samber/ro/plugins/nats
+
+go 1.20
+
+require (
+	github.com/nats-io/nats.go v1.31.0
+	github.com/samber/ro v0.0.0
+)
+
+require (
+	github.com/klauspost/compress v1.17.0 // indirect
+	github.com/nats-io/nkeys v0.4.5 // indirect
+	github.com/nats-io/nuid v1.0.1 // indirect
+	github.com/samber/lo v1.52.0 // indirect
+	golang.org/x/crypto v0.6.0 // indirect
+	golang.org/x/exp v0.0.0-20220303212507-bbda1eaf7a17 // indirect
+	golang.org/x/sys v0.5.0 // indirect
+	golang.org/x/text v0.22.0 // indirect
+)
+
+replace github.com/samber/ro => ../..
@@ -0,0 +1,44 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ronats
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/samber/ro"
+)
+
+type NATSObservableConfig[Out any] struct {
+	Conn         *nats.Conn
+	Subject      string
+	Deserializer Deserializer[Out]
+	Durable      string
+
+	// Connector is a function that returns a Subject[Out].
+	// This is useful when you want to use a different Subject implementation.
+	// For example, you could use a ReplaySubject to replay the last N messages.
+	OutputConnector func() ro.Subject[Out]
+}
+
+// NewNATSObservable creates a NATS observable that receives messages from a NATS subject.
+func NewNATSObservable[Out any](config NATSObservableConfig[Out]) ro.Observable[Out] {
+	return NewNATSSubject(NATSSubjectConfig[struct{}, Out]{
+		Conn:            config.Conn,
+		Subject:         config.Subject,
+		Serializer:      func(value struct{}) ([]byte, error) { return []byte{}, nil },
+		Deserializer:    config.Deserializer,
+		Durable:         config.Durable,
+		OutputConnector: config.OutputConnector,
+	})
+}
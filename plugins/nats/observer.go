@@ -0,0 +1,37 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ronats
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/samber/ro"
+)
+
+type NATSObserverConfig[In any] struct {
+	Conn       *nats.Conn
+	Subject    string
+	Serializer Serializer[In]
+}
+
+// NewNATSObserver creates a NATS observer that publishes messages to a NATS subject.
+func NewNATSObserver[In any](config NATSObserverConfig[In]) ro.Observer[In] {
+	return NewNATSSubject(NATSSubjectConfig[In, struct{}]{
+		Conn:            config.Conn,
+		Subject:         config.Subject,
+		Serializer:      config.Serializer,
+		Deserializer:    func([]byte) (struct{}, error) { return struct{}{}, nil },
+		OutputConnector: ro.NewPublishSubject[struct{}],
+	})
+}
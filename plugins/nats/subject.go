@@ -0,0 +1,256 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ronats
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/samber/ro"
+)
+
+type Serializer[T any] func(T) ([]byte, error)
+
+type Deserializer[T any] func([]byte) (T, error)
+
+type NATSSubjectConfig[In any, Out any] struct {
+	Conn         *nats.Conn
+	Subject      string
+	Serializer   Serializer[In]
+	Deserializer Deserializer[Out]
+
+	// Durable enables a JetStream durable consumer with the given name
+	// instead of a plain NATS subscription. Messages are acknowledged after
+	// they have been deserialized and emitted.
+	Durable string
+
+	// Connector is a function that returns a Subject[Out].
+	// This is useful when you want to use a different Subject implementation.
+	// For example, you could use a ReplaySubject to replay the last N messages.
+	OutputConnector func() ro.Subject[Out]
+}
+
+// NewNATSSubject creates a NATS subject that can both publish and receive messages from a NATS subject.
+func NewNATSSubject[In any, Out any](config NATSSubjectConfig[In, Out]) *natsSubject[In, Out] {
+	if config.Conn == nil {
+		panic("ronats.NewNATSSubject: Conn is required")
+	}
+	if config.Subject == "" {
+		panic("ronats.NewNATSSubject: Subject is required")
+	}
+	if config.Serializer == nil {
+		panic("ronats.NewNATSSubject: Serializer is required")
+	}
+	if config.Deserializer == nil {
+		panic("ronats.NewNATSSubject: Deserializer is required")
+	}
+
+	// Set default output connector
+	if config.OutputConnector == nil {
+		config.OutputConnector = func() ro.Subject[Out] {
+			return ro.NewPublishSubject[Out]()
+		}
+	}
+
+	return &natsSubject[In, Out]{
+		config: config,
+		output: nil,
+	}
+}
+
+var _ ro.Subject[string] = (*natsSubject[string, string])(nil)
+var _ ro.Observer[string] = (*natsSubject[string, int])(nil)
+var _ ro.Observable[string] = (*natsSubject[int, string])(nil)
+
+type natsSubject[In any, Out any] struct {
+	config NATSSubjectConfig[In, Out]
+	sub    *nats.Subscription
+	output ro.Subject[Out]
+	mu     sync.RWMutex
+}
+
+// Implements ro.Observable[Out]
+func (ns *natsSubject[In, Out]) Subscribe(destination ro.Observer[Out]) ro.Subscription {
+	return ns.SubscribeWithContext(context.Background(), destination)
+}
+
+// Implements ro.Observable[Out]
+func (ns *natsSubject[In, Out]) SubscribeWithContext(ctx context.Context, destination ro.Observer[Out]) ro.Subscription {
+	output, err := ns.connect()
+	if err != nil {
+		destination.ErrorWithContext(context.TODO(), err)
+		sub := ro.NewSubscription(nil)
+		sub.Unsubscribe()
+		return sub
+	}
+
+	return output.SubscribeWithContext(ctx, destination)
+}
+
+// Implements ro.Observer[In]
+func (ns *natsSubject[In, Out]) Next(value In) {
+	ns.NextWithContext(context.Background(), value)
+}
+
+// Implements ro.Observer[In]
+func (ns *natsSubject[In, Out]) NextWithContext(ctx context.Context, value In) {
+	_, err := ns.connect()
+	if err != nil {
+		ns.ErrorWithContext(ctx, err)
+		return
+	}
+
+	data, err := ns.config.Serializer(value)
+	if err != nil {
+		ns.ErrorWithContext(ctx, err)
+		return
+	}
+
+	err = ns.config.Conn.Publish(ns.config.Subject, data)
+	if err != nil {
+		ns.ErrorWithContext(ctx, err)
+	}
+}
+
+// Implements ro.Observer[In]
+func (ns *natsSubject[In, Out]) Error(err error) {
+	ns.ErrorWithContext(context.Background(), err)
+}
+
+// Implements ro.Observer[In]
+func (ns *natsSubject[In, Out]) ErrorWithContext(ctx context.Context, err error) {
+	ns.output.ErrorWithContext(ctx, err)
+	ns.disconnect()
+}
+
+// Implements ro.Observer[In]
+func (ns *natsSubject[In, Out]) Complete() {
+	ns.CompleteWithContext(context.Background())
+}
+
+// Implements ro.Observer[In]
+func (ns *natsSubject[In, Out]) CompleteWithContext(ctx context.Context) {
+	ns.output.CompleteWithContext(ctx)
+	ns.disconnect()
+}
+
+// Implements ro.Observer[In]
+func (ns *natsSubject[In, Out]) IsClosed() bool {
+	return ns.output.IsClosed()
+}
+
+// Implements ro.Observer[In]
+func (ns *natsSubject[In, Out]) HasThrown() bool {
+	return ns.output.HasThrown()
+}
+
+// Implements ro.Observer[In]
+func (ns *natsSubject[In, Out]) IsCompleted() bool {
+	return ns.output.IsCompleted()
+}
+
+// Implements ro.Subject[Out]
+func (ns *natsSubject[In, Out]) HasObserver() bool {
+	return ns.output.HasObserver()
+}
+
+// Implements ro.Subject[Out]
+func (ns *natsSubject[In, Out]) CountObservers() int {
+	return ns.output.CountObservers()
+}
+
+// Implements ro.Subject[Out]
+func (ns *natsSubject[In, Out]) AsObservable() ro.Observable[Out] {
+	return ns
+}
+
+// Implements ro.Subject[In]
+func (ns *natsSubject[In, Out]) AsObserver() ro.Observer[In] {
+	return ns
+}
+
+// connect establishes the NATS subscription
+func (ns *natsSubject[In, Out]) connect() (ro.Subject[Out], error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if ns.sub != nil && ns.output != nil {
+		return ns.output, nil // Already subscribed
+	}
+
+	output := ns.config.OutputConnector()
+
+	handler := func(msg *nats.Msg) bool {
+		value, err := ns.config.Deserializer(msg.Data)
+		if err != nil {
+			output.ErrorWithContext(context.TODO(), err)
+			return false
+		}
+
+		output.NextWithContext(context.TODO(), value)
+
+		return true
+	}
+
+	var sub *nats.Subscription
+
+	var err error
+
+	if ns.config.Durable != "" {
+		js, jsErr := ns.config.Conn.JetStream()
+		if jsErr != nil {
+			return nil, jsErr
+		}
+
+		sub, err = js.Subscribe(
+			ns.config.Subject,
+			func(msg *nats.Msg) {
+				// acknowledge only once deserialized and emitted, so a failed
+				// message is redelivered
+				if handler(msg) {
+					_ = msg.Ack()
+				}
+			},
+			nats.Durable(ns.config.Durable),
+			nats.ManualAck(),
+		)
+	} else {
+		sub, err = ns.config.Conn.Subscribe(ns.config.Subject, func(msg *nats.Msg) {
+			handler(msg)
+		})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	ns.sub = sub
+	ns.output = output
+
+	return ns.output, nil
+}
+
+// disconnect drains the NATS subscription
+func (ns *natsSubject[In, Out]) disconnect() {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if ns.sub != nil {
+		_ = ns.sub.Drain()
+		ns.sub = nil
+		ns.output = nil
+	}
+}
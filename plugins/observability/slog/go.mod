@@ -2,18 +2,12 @@ module github.com/samber/ro/plugins/observability/slog
 
 go 1.21
 
-require (
-	github.com/samber/ro v0.0.0
-	github.com/stretchr/testify v1.8.1
-)
+require github.com/samber/ro v0.0.0
 
 require (
-	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/samber/lo v1.47.0 // indirect
+	github.com/samber/lo v1.52.0 // indirect
 	golang.org/x/exp v0.0.0-20220303212507-bbda1eaf7a17 // indirect
-	golang.org/x/text v0.16.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
+	golang.org/x/text v0.22.0 // indirect
 )
 
 replace github.com/samber/ro => ../../..
@@ -17,12 +17,16 @@ package rolog
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand"
 
 	"github.com/samber/ro"
 )
 
+var ErrSampleLogWrongRate = errors.New("rolog.SampleLog: rate must be between 0 and 1")
+
 func Log[T any](logger slog.Logger, level slog.Level) func(ro.Observable[T]) ro.Observable[T] {
 	return ro.TapWithContext(
 		func(ctx context.Context, value T) {
@@ -50,3 +54,28 @@ func LogWithNotification[T any](logger slog.Logger, level slog.Level) func(ro.Ob
 		},
 	)
 }
+
+// SampleLog logs a random fraction of the values flowing through the stream,
+// plus every error and the completion. It is the production counterpart to Log
+// for fire-hose streams, where logging every value is infeasible. Sampling
+// costs a single random float comparison per value.
+// It panics if rate is not between 0 and 1.
+func SampleLog[T any](rate float64, logger *slog.Logger, label string) func(ro.Observable[T]) ro.Observable[T] {
+	if rate < 0 || rate > 1 {
+		panic(ErrSampleLogWrongRate)
+	}
+
+	return ro.TapWithContext(
+		func(ctx context.Context, value T) {
+			if rand.Float64() < rate { //nolint:gosec
+				logger.LogAttrs(ctx, slog.LevelInfo, "ro.Next", slog.String("label", label), slog.Any("value", value))
+			}
+		},
+		func(ctx context.Context, err error) {
+			logger.LogAttrs(ctx, slog.LevelError, "ro.Error", slog.String("label", label), slog.Any("error", err))
+		},
+		func(ctx context.Context) {
+			logger.LogAttrs(ctx, slog.LevelInfo, "ro.Complete", slog.String("label", label))
+		},
+	)
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ropersist
+
+import (
+	"encoding/json"
+)
+
+// Codec serializes the values emitted by a pipeline so they can be persisted
+// in a CacheStore and replayed later.
+type Codec[T any] interface {
+	Encode(values []T) ([]byte, error)
+	Decode(payload []byte) ([]T, error)
+}
+
+type jsonCodec[T any] struct{}
+
+// NewJSONCodec creates a Codec that serializes values using encoding/json.
+func NewJSONCodec[T any]() Codec[T] {
+	return &jsonCodec[T]{}
+}
+
+func (c *jsonCodec[T]) Encode(values []T) ([]byte, error) {
+	return json.Marshal(values)
+}
+
+func (c *jsonCodec[T]) Decode(payload []byte) ([]T, error) {
+	var values []T
+	if err := json.Unmarshal(payload, &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ropersist
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// PersistResult caches the values of a deterministic, completing pipeline in
+// the given store. On the first subscription, the upstream Observable runs
+// normally and its values are teed into the store once it completes. On
+// subsequent subscriptions within the TTL (including after a process restart),
+// the values are replayed from the store without subscribing upstream.
+//
+// Terminal errors are not cached: an upstream error is forwarded and the next
+// subscription runs the upstream again. A corrupted or undecodable entry is
+// treated as a cache miss. Store read/write failures are reported through
+// ro.OnUnhandledError and the pipeline falls back to the upstream.
+//
+// Use store.Invalidate(key) to force the next subscription to re-run the
+// upstream.
+func PersistResult[T any](store CacheStore, key string, codec Codec[T], ttl time.Duration) func(ro.Observable[T]) ro.Observable[T] {
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			payload, ok, err := store.Get(key)
+			if err != nil {
+				ro.OnUnhandledError(subscriberCtx, err)
+			} else if ok {
+				values, err := codec.Decode(payload)
+				if err != nil {
+					// corrupted entry: drop it and fall back to the upstream
+					ro.OnUnhandledError(subscriberCtx, err)
+					_ = store.Invalidate(key)
+				} else {
+					for _, value := range values {
+						destination.NextWithContext(subscriberCtx, value)
+					}
+
+					destination.CompleteWithContext(subscriberCtx)
+
+					return nil
+				}
+			}
+
+			buffer := []T{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						buffer = append(buffer, value)
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						payload, err := codec.Encode(buffer)
+						if err != nil {
+							ro.OnUnhandledError(ctx, err)
+						} else if err := store.Set(key, payload, ttl); err != nil {
+							ro.OnUnhandledError(ctx, err)
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ropersist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistResult(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	store := NewFilesystemStore(t.TempDir())
+	codec := NewJSONCodec[int]()
+
+	subscriptions := 0
+	source := ro.Defer(func() ro.Observable[int] {
+		subscriptions++
+		return ro.Just(1, 2, 3)
+	})
+
+	observable := ro.Pipe1(
+		source,
+		PersistResult(store, "result", codec, time.Minute),
+	)
+
+	values, err := ro.Collect(observable)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+	is.Equal(1, subscriptions)
+
+	// second subscription replays from the store
+	values, err = ro.Collect(observable)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+	is.Equal(1, subscriptions)
+
+	// invalidation forces the upstream to run again
+	is.NoError(store.Invalidate("result"))
+
+	values, err = ro.Collect(observable)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+	is.Equal(2, subscriptions)
+}
+
+func TestPersistResultError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	store := NewFilesystemStore(t.TempDir())
+	codec := NewJSONCodec[int]()
+
+	subscriptions := 0
+	source := ro.Defer(func() ro.Observable[int] {
+		subscriptions++
+		return ro.Throw[int](assert.AnError)
+	})
+
+	observable := ro.Pipe1(
+		source,
+		PersistResult(store, "result", codec, time.Minute),
+	)
+
+	// terminal errors are not cached
+	values, err := ro.Collect(observable)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.Equal(1, subscriptions)
+
+	values, err = ro.Collect(observable)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.Equal(2, subscriptions)
+}
+
+func TestPersistResultTTL(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	store := NewFilesystemStore(t.TempDir())
+	codec := NewJSONCodec[int]()
+
+	subscriptions := 0
+	source := ro.Defer(func() ro.Observable[int] {
+		subscriptions++
+		return ro.Just(42)
+	})
+
+	observable := ro.Pipe1(
+		source,
+		PersistResult(store, "result", codec, time.Millisecond),
+	)
+
+	_, err := ro.Collect(observable)
+	is.NoError(err)
+	is.Equal(1, subscriptions)
+
+	time.Sleep(10 * time.Millisecond)
+
+	// expired entry: the upstream runs again
+	values, err := ro.Collect(observable)
+	is.Equal([]int{42}, values)
+	is.NoError(err)
+	is.Equal(2, subscriptions)
+}
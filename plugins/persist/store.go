@@ -0,0 +1,145 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ropersist
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrChecksumMismatch is returned by a store when a cached entry is corrupted.
+var ErrChecksumMismatch = errors.New("ropersist: checksum mismatch")
+
+// CacheStore persists encoded pipeline results under a key. Implementations
+// must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the payload stored under the key. The second return value
+	// is false when the key is absent or expired.
+	Get(key string) ([]byte, bool, error)
+	// Set stores the payload under the key. A ttl lower than or equal to 0
+	// means no expiration.
+	Set(key string, payload []byte, ttl time.Duration) error
+	// Invalidate removes the key from the store. Removing an absent key is
+	// not an error.
+	Invalidate(key string) error
+}
+
+type filesystemEntry struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Checksum  string    `json:"checksum"`
+	Payload   []byte    `json:"payload"`
+}
+
+type filesystemStore struct {
+	dir string
+}
+
+// NewFilesystemStore creates a CacheStore that persists entries as files in
+// the given directory, one file per key. Writes are atomic (temporary file
+// followed by a rename) and entries carry a checksum that is verified on read.
+func NewFilesystemStore(dir string) CacheStore {
+	return &filesystemStore{dir: dir}
+}
+
+func (s *filesystemStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".cache")
+}
+
+func (s *filesystemStore) Get(key string) ([]byte, bool, error) {
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	var entry filesystemEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false, err
+	}
+
+	if !entry.ExpiresAt.IsZero() && time.Now().After(entry.ExpiresAt) {
+		_ = s.Invalidate(key)
+
+		return nil, false, nil
+	}
+
+	sum := sha256.Sum256(entry.Payload)
+	if hex.EncodeToString(sum[:]) != entry.Checksum {
+		return nil, false, ErrChecksumMismatch
+	}
+
+	return entry.Payload, true, nil
+}
+
+func (s *filesystemStore) Set(key string, payload []byte, ttl time.Duration) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(payload)
+
+	entry := filesystemEntry{
+		Checksum: hex.EncodeToString(sum[:]),
+		Payload:  payload,
+	}
+	if ttl > 0 {
+		entry.ExpiresAt = time.Now().Add(ttl)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	path := s.path(key)
+
+	tmp, err := os.CreateTemp(s.dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *filesystemStore) Invalidate(key string) error {
+	err := os.Remove(s.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
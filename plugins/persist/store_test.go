@@ -0,0 +1,64 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ropersist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilesystemStore(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	store := NewFilesystemStore(t.TempDir())
+
+	_, ok, err := store.Get("missing")
+	is.False(ok)
+	is.NoError(err)
+
+	is.NoError(store.Set("key", []byte("payload"), 0))
+
+	payload, ok, err := store.Get("key")
+	is.Equal([]byte("payload"), payload)
+	is.True(ok)
+	is.NoError(err)
+
+	is.NoError(store.Invalidate("key"))
+
+	_, ok, err = store.Get("key")
+	is.False(ok)
+	is.NoError(err)
+
+	// invalidating an absent key is not an error
+	is.NoError(store.Invalidate("key"))
+}
+
+func TestFilesystemStoreTTL(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	store := NewFilesystemStore(t.TempDir())
+
+	is.NoError(store.Set("key", []byte("payload"), time.Millisecond))
+
+	time.Sleep(10 * time.Millisecond)
+
+	_, ok, err := store.Get("key")
+	is.False(ok)
+	is.NoError(err)
+}
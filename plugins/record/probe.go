@@ -0,0 +1,76 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package rorecord
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"time"
+)
+
+// LogInfo describes a log produced by Record.
+type LogInfo struct {
+	// StartTime is the timestamp of the first recorded notification.
+	StartTime time.Time
+	// EndTime is the timestamp of the last recorded notification.
+	EndTime time.Time
+	// Count is the number of recorded Next notifications.
+	Count int64
+	// Completed reports whether the log ends with a complete notification.
+	Completed bool
+	// Errored reports whether the log ends with an error notification.
+	Errored bool
+}
+
+// Probe scans a log produced by Record and returns its metadata (start/end
+// time, notification count, terminal state) without replaying it. It is useful
+// to locate an incident before seeking into the log with
+// ReplayFromLogWithConfig.
+func Probe(reader io.ReaderAt) (LogInfo, error) {
+	info := LogInfo{}
+
+	decoder := json.NewDecoder(io.NewSectionReader(reader, 0, math.MaxInt64))
+
+	for {
+		var entry logEntry
+		if err := decoder.Decode(&entry); err != nil {
+			if !errors.Is(err, io.EOF) {
+				return info, err
+			}
+
+			return info, nil
+		}
+
+		at := time.Unix(0, entry.At)
+
+		if info.StartTime.IsZero() {
+			info.StartTime = at
+		}
+
+		info.EndTime = at
+
+		switch entry.Kind {
+		case kindNext:
+			info.Count++
+		case kindError:
+			info.Errored = true
+		case kindComplete:
+			info.Completed = true
+		}
+	}
+}
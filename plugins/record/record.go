@@ -0,0 +1,122 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package rorecord
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// Log entry kinds.
+const (
+	kindNext     = "next"
+	kindError    = "error"
+	kindComplete = "complete"
+)
+
+// logEntry is a single recorded notification. Entries are serialized as JSON
+// lines, so a recorded log can be inspected with standard tooling.
+type logEntry struct {
+	At    int64           `json:"at"` // unix nanoseconds
+	Kind  string          `json:"kind"`
+	Value json.RawMessage `json:"value,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// Codec serializes and deserializes recorded values. It lets RecordWithCodec
+// and ReplayFromLogWithCodec carry payloads in another format than JSON (eg:
+// protobuf, gob) while keeping the timestamped JSON-lines envelope. Non-JSON
+// payloads are embedded in the envelope as base64 strings.
+type Codec[T any] interface {
+	Marshal(value T) ([]byte, error)
+	Unmarshal(data []byte, value *T) error
+}
+
+// Record tees all notifications of the source Observable into the writer as
+// timestamped JSON lines, and forwards them downstream unchanged. The
+// resulting log can be replayed with ReplayFromLog, inspected with Probe, or
+// read with standard JSON tooling.
+//
+// A write or marshaling failure is forwarded downstream as an error.
+func Record[T any](writer io.Writer) func(ro.Observable[T]) ro.Observable[T] {
+	return record[T](writer, func(value T) (json.RawMessage, error) {
+		return json.Marshal(value)
+	})
+}
+
+// RecordWithCodec behaves like Record, but serializes values with the given
+// codec instead of encoding/json. The codec output is embedded in the
+// JSON-lines envelope as a base64 string, and the log must be replayed with
+// ReplayFromLogWithCodec using the same codec.
+func RecordWithCodec[T any](writer io.Writer, codec Codec[T]) func(ro.Observable[T]) ro.Observable[T] {
+	return record[T](writer, func(value T) (json.RawMessage, error) {
+		payload, err := codec.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(payload)
+	})
+}
+
+func record[T any](writer io.Writer, encode func(value T) (json.RawMessage, error)) func(ro.Observable[T]) ro.Observable[T] {
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			encoder := json.NewEncoder(writer)
+
+			write := func(entry logEntry) error {
+				entry.At = time.Now().UnixNano()
+				return encoder.Encode(entry)
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						payload, err := encode(value)
+						if err != nil {
+							destination.ErrorWithContext(ctx, err)
+
+							return
+						}
+
+						if err := write(logEntry{Kind: kindNext, Value: payload}); err != nil {
+							destination.ErrorWithContext(ctx, err)
+
+							return
+						}
+
+						destination.NextWithContext(ctx, value)
+					},
+					func(ctx context.Context, err error) {
+						_ = write(logEntry{Kind: kindError, Error: err.Error()})
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						_ = write(logEntry{Kind: kindComplete})
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
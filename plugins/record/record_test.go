@@ -0,0 +1,206 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package rorecord
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buf bytes.Buffer
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(1, 2, 3),
+			Record[int](&buf),
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = ro.Collect(
+		ReplayFromLog[int](bytes.NewReader(buf.Bytes())),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+}
+
+func TestRecordAndReplayError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buf bytes.Buffer
+
+	_, err := ro.Collect(
+		ro.Pipe1(
+			ro.ConcatWith(ro.Throw[int](assert.AnError))(ro.Just(1, 2)),
+			Record[int](&buf),
+		),
+	)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err := ro.Collect(
+		ReplayFromLog[int](bytes.NewReader(buf.Bytes())),
+	)
+	is.Equal([]int{1, 2}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestReplayFromLogWithConfigSeek(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buf bytes.Buffer
+
+	_, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(1, 2, 3, 4),
+			Record[int](&buf),
+		),
+	)
+	is.NoError(err)
+
+	values, err := ro.Collect(
+		ReplayFromLogWithConfig[int](bytes.NewReader(buf.Bytes()), ReplayConfig{
+			StartAtIndex: 2,
+		}),
+	)
+	is.Equal([]int{3, 4}, values)
+	is.NoError(err)
+}
+
+func TestReplayFromLogWithConfigSpeed(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buf bytes.Buffer
+
+	_, err := ro.Collect(
+		ro.Pipe1(
+			ro.RangeWithInterval(1, 4, 50*time.Millisecond),
+			Record[int64](&buf),
+		),
+	)
+	is.NoError(err)
+
+	// the original stream took ~150ms, a 10x replay should be much faster
+	start := time.Now()
+
+	values, err := ro.Collect(
+		ReplayFromLogWithConfig[int64](bytes.NewReader(buf.Bytes()), ReplayConfig{
+			Speed: ro.Just(10.0),
+		}),
+	)
+	is.Equal([]int64{1, 2, 3}, values)
+	is.NoError(err)
+	is.Less(time.Since(start), 100*time.Millisecond)
+}
+
+type upperCodec struct{}
+
+func (upperCodec) Marshal(value string) ([]byte, error) {
+	return []byte(strings.ToUpper(value)), nil
+}
+
+func (upperCodec) Unmarshal(data []byte, value *string) error {
+	*value = strings.ToLower(string(data))
+	return nil
+}
+
+func TestRecordAndReplayWithCodec(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buf bytes.Buffer
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("foo", "bar"),
+			RecordWithCodec[string](&buf, upperCodec{}),
+		),
+	)
+	is.Equal([]string{"foo", "bar"}, values)
+	is.NoError(err)
+
+	// the codec output is embedded in the envelope as base64
+	is.Contains(buf.String(), base64.StdEncoding.EncodeToString([]byte("FOO")))
+
+	values, err = ro.Collect(
+		ReplayFromLogWithCodec[string](bytes.NewReader(buf.Bytes()), upperCodec{}),
+	)
+	is.Equal([]string{"foo", "bar"}, values)
+	is.NoError(err)
+}
+
+func TestReplayFromLogWithConfigDisableTiming(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buf bytes.Buffer
+
+	_, err := ro.Collect(
+		ro.Pipe1(
+			ro.RangeWithInterval(1, 4, 50*time.Millisecond),
+			Record[int64](&buf),
+		),
+	)
+	is.NoError(err)
+
+	// the original stream took ~150ms, the replay should be near-instant
+	start := time.Now()
+
+	values, err := ro.Collect(
+		ReplayFromLogWithConfig[int64](bytes.NewReader(buf.Bytes()), ReplayConfig{
+			DisableTiming: true,
+		}),
+	)
+	is.Equal([]int64{1, 2, 3}, values)
+	is.NoError(err)
+	is.Less(time.Since(start), 50*time.Millisecond)
+}
+
+func TestProbe(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buf bytes.Buffer
+
+	_, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(1, 2, 3),
+			Record[int](&buf),
+		),
+	)
+	is.NoError(err)
+
+	info, err := Probe(bytes.NewReader(buf.Bytes()))
+	is.NoError(err)
+	is.Equal(int64(3), info.Count)
+	is.True(info.Completed)
+	is.False(info.Errored)
+	is.False(info.StartTime.IsZero())
+	is.False(info.EndTime.Before(info.StartTime))
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package rorecord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// ReplayConfig is the configuration for the ReplayFromLog source.
+type ReplayConfig struct {
+	// StartAtTime skips all notifications recorded before this timestamp.
+	// The zero value disables time-based seeking.
+	StartAtTime time.Time
+	// StartAtIndex skips the first StartAtIndex notifications of the log.
+	// It is applied after StartAtTime.
+	StartAtIndex int64
+	// Speed scales the delays between replayed notifications: 2.0 replays
+	// twice as fast, 0.5 twice as slow. It can be fed by a live Observable
+	// (e.g. a debug UI) and takes effect on the next notification. Values
+	// lower than or equal to 0 are ignored. When nil, the log is replayed
+	// at 1x speed.
+	Speed ro.Observable[float64]
+	// DisableTiming replays the log as fast as possible instead of preserving
+	// the recorded inter-event delays.
+	DisableTiming bool
+}
+
+// ReplayFromLog creates an Observable that replays a log produced by Record,
+// preserving the original delays between notifications. The Observable
+// completes when the log ends, even if no terminal notification was recorded.
+func ReplayFromLog[T any](reader io.Reader) ro.Observable[T] {
+	return ReplayFromLogWithConfig[T](reader, ReplayConfig{})
+}
+
+// ReplayFromLogWithConfig creates an Observable that replays a log produced
+// by Record. The configuration allows seeking to a timestamp or notification
+// index before starting emission, and scaling the replay speed dynamically.
+func ReplayFromLogWithConfig[T any](reader io.Reader, config ReplayConfig) ro.Observable[T] {
+	return replayFromLog(reader, config, func(raw json.RawMessage, value *T) error {
+		return json.Unmarshal(raw, value)
+	})
+}
+
+// ReplayFromLogWithCodec creates an Observable that replays a log produced by
+// RecordWithCodec, deserializing values with the same codec and preserving the
+// original delays between notifications.
+func ReplayFromLogWithCodec[T any](reader io.Reader, codec Codec[T]) ro.Observable[T] {
+	return ReplayFromLogWithCodecAndConfig(reader, codec, ReplayConfig{})
+}
+
+// ReplayFromLogWithCodecAndConfig creates an Observable that replays a log
+// produced by RecordWithCodec, deserializing values with the same codec. The
+// configuration allows seeking, scaling the replay speed, or disabling the
+// recorded timing entirely.
+func ReplayFromLogWithCodecAndConfig[T any](reader io.Reader, codec Codec[T], config ReplayConfig) ro.Observable[T] {
+	return replayFromLog(reader, config, func(raw json.RawMessage, value *T) error {
+		var payload []byte
+		if err := json.Unmarshal(raw, &payload); err != nil {
+			return err
+		}
+
+		return codec.Unmarshal(payload, value)
+	})
+}
+
+func replayFromLog[T any](reader io.Reader, config ReplayConfig, decode func(raw json.RawMessage, value *T) error) ro.Observable[T] {
+	return ro.NewObservableWithContext(func(ctx context.Context, destination ro.Observer[T]) ro.Teardown {
+		done := make(chan struct{})
+
+		speed := uint64(math.Float64bits(1.0))
+
+		subscriptions := ro.NewSubscription(nil)
+
+		if config.Speed != nil {
+			subscriptions.AddUnsubscribable(
+				config.Speed.SubscribeWithContext(
+					ctx,
+					ro.OnNext(func(value float64) {
+						if value > 0 {
+							atomic.StoreUint64(&speed, math.Float64bits(value))
+						}
+					}),
+				),
+			)
+		}
+
+		go func() {
+			decoder := json.NewDecoder(reader)
+
+			index := int64(0)
+			prevAt := int64(0)
+
+			for {
+				var entry logEntry
+				if err := decoder.Decode(&entry); err != nil {
+					if !errors.Is(err, io.EOF) {
+						destination.ErrorWithContext(ctx, err)
+
+						return
+					}
+
+					destination.CompleteWithContext(ctx)
+
+					return
+				}
+
+				index++
+
+				skipped := entry.Kind == kindNext &&
+					(entry.At < config.StartAtTime.UnixNano() || index <= config.StartAtIndex)
+
+				if !skipped && !config.DisableTiming && prevAt > 0 {
+					delta := time.Duration(entry.At - prevAt)
+					scaled := time.Duration(float64(delta) / math.Float64frombits(atomic.LoadUint64(&speed)))
+
+					timer := time.NewTimer(scaled)
+
+					select {
+					case <-done:
+						timer.Stop()
+
+						return
+					case <-ctx.Done():
+						timer.Stop()
+
+						return
+					case <-timer.C:
+					}
+				}
+
+				if !skipped {
+					prevAt = entry.At
+				}
+
+				switch entry.Kind {
+				case kindNext:
+					if skipped {
+						continue
+					}
+
+					var value T
+					if err := decode(entry.Value, &value); err != nil {
+						destination.ErrorWithContext(ctx, err)
+
+						return
+					}
+
+					destination.NextWithContext(ctx, value)
+				case kindError:
+					destination.ErrorWithContext(ctx, errors.New(entry.Error))
+
+					return
+				case kindComplete:
+					destination.CompleteWithContext(ctx)
+
+					return
+				}
+			}
+		}()
+
+		return func() {
+			subscriptions.Unsubscribe()
+			close(done)
+		}
+	})
+}
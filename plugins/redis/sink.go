@@ -0,0 +1,53 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roredis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/ro"
+)
+
+// NewRedisStreamSink appends each value to a Redis stream with XADD, forwarding
+// the values downstream unchanged. A failed XADD stops the stream with an
+// error.
+func NewRedisStreamSink(client *redis.Client, stream string) func(ro.Observable[map[string]any]) ro.Observable[map[string]any] {
+	return func(source ro.Observable[map[string]any]) ro.Observable[map[string]any] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[map[string]any]) ro.Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, values map[string]any) {
+						err := client.XAdd(ctx, &redis.XAddArgs{
+							Stream: stream,
+							Values: values,
+						}).Err()
+						if err != nil {
+							destination.ErrorWithContext(ctx, fmt.Errorf("roredis.NewRedisStreamSink: %w", err))
+						} else {
+							destination.NextWithContext(ctx, values)
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
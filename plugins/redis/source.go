@@ -0,0 +1,118 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roredis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/ro"
+)
+
+// readBlockInterval is how long a single XREADGROUP call blocks waiting for
+// new entries before being retried, bounding the cancellation latency.
+const readBlockInterval = 1 * time.Second
+
+// RedisEntry is a single entry read from a Redis stream.
+type RedisEntry struct {
+	Stream string
+	ID     string
+	Values map[string]any
+}
+
+// NewRedisStreamSource creates an observable that consumes a Redis stream with
+// XREADGROUP, as `consumer` in the consumer group `group`. The group is
+// created with MKSTREAM if it does not exist. On subscription, the pending
+// entries of the consumer are reclaimed and re-emitted first, then the source
+// blocks for new entries. Each entry is acknowledged with XACK after the
+// downstream observer has processed it, so an entry delivered to a crashing
+// pipeline stays in the pending entries list and is replayed on the next
+// subscription. The source stops when the subscription context is canceled.
+func NewRedisStreamSource(client *redis.Client, stream string, group string, consumer string) ro.Observable[RedisEntry] {
+	return ro.NewObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[RedisEntry]) ro.Teardown {
+		ctx, cancel := context.WithCancel(subscriberCtx)
+
+		fail := func(err error) {
+			destination.ErrorWithContext(ctx, fmt.Errorf("roredis.NewRedisStreamSource: %w", err))
+		}
+
+		go func() {
+			err := client.XGroupCreateMkStream(ctx, stream, group, "0").Err()
+			if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+				if ctx.Err() != nil {
+					destination.CompleteWithContext(ctx)
+				} else {
+					fail(err)
+				}
+
+				return
+			}
+
+			// "0" delivers the pending entries of this consumer first; after
+			// the backlog has been replayed, ">" blocks for new entries
+			id := "0"
+
+			for {
+				streams, err := client.XReadGroup(ctx, &redis.XReadGroupArgs{
+					Group:    group,
+					Consumer: consumer,
+					Streams:  []string{stream, id},
+					Block:    readBlockInterval,
+				}).Result()
+				if err != nil {
+					if ctx.Err() != nil {
+						destination.CompleteWithContext(ctx)
+						return
+					}
+
+					if errors.Is(err, redis.Nil) {
+						continue
+					}
+
+					fail(err)
+
+					return
+				}
+
+				for _, s := range streams {
+					for _, message := range s.Messages {
+						destination.NextWithContext(ctx, RedisEntry{
+							Stream: s.Stream,
+							ID:     message.ID,
+							Values: message.Values,
+						})
+
+						// acknowledge once the downstream has processed the entry
+						err := client.XAck(ctx, stream, group, message.ID).Err()
+						if err != nil && ctx.Err() == nil {
+							fail(err)
+							return
+						}
+					}
+				}
+
+				id = ">"
+			}
+		}()
+
+		return func() {
+			cancel()
+		}
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package rosql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/samber/ro"
+)
+
+// NewRowsObservable creates an observable that runs a query and emits one T
+// per row. Struct fields are matched to columns through their `db` tag, or
+// their lowercased name when untagged; columns without a matching field are
+// discarded and fields tagged `db:"-"` are never scanned. A non-struct T is
+// scanned directly from single-column result sets. The query runs lazily on
+// subscription, query, scan and driver errors are emitted as error
+// notifications, and the rows are closed on teardown.
+func NewRowsObservable[T any](ctx context.Context, db *sql.DB, query string, args ...any) ro.Observable[T] {
+	return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+		rows, err := db.QueryContext(ctx, query, args...)
+		if err != nil {
+			destination.ErrorWithContext(subscriberCtx, fmt.Errorf("rosql.NewRowsObservable: %w", err))
+			return nil
+		}
+
+		columns, err := rows.Columns()
+		if err != nil {
+			_ = rows.Close()
+			destination.ErrorWithContext(subscriberCtx, fmt.Errorf("rosql.NewRowsObservable: %w", err))
+			return nil
+		}
+
+		indexes, err := columnFieldIndexes(reflect.TypeOf((*T)(nil)).Elem(), columns)
+		if err != nil {
+			_ = rows.Close()
+			destination.ErrorWithContext(subscriberCtx, fmt.Errorf("rosql.NewRowsObservable: %w", err))
+			return nil
+		}
+
+		for !destination.IsClosed() && rows.Next() {
+			var value T
+			if err := rows.Scan(scanTargets(&value, indexes)...); err != nil {
+				_ = rows.Close()
+				destination.ErrorWithContext(subscriberCtx, fmt.Errorf("rosql.NewRowsObservable: %w", err))
+				return func() {}
+			}
+
+			destination.NextWithContext(subscriberCtx, value)
+		}
+
+		if err := rows.Err(); err != nil {
+			destination.ErrorWithContext(subscriberCtx, fmt.Errorf("rosql.NewRowsObservable: %w", err))
+		} else {
+			destination.CompleteWithContext(subscriberCtx)
+		}
+
+		return func() {
+			_ = rows.Close()
+		}
+	})
+}
+
+// columnFieldIndexes maps every column of the result set to a field index of
+// the struct T, or to -1 when the column has no matching field. A nil slice is
+// returned for non-struct types, which are scanned as a whole.
+func columnFieldIndexes(t reflect.Type, columns []string) ([]int, error) {
+	if t.Kind() != reflect.Struct {
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("cannot scan %d columns into %s", len(columns), t.String())
+		}
+
+		return nil, nil
+	}
+
+	fieldByColumn := map[string]int{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := strings.ToLower(field.Name)
+		if tag, ok := field.Tag.Lookup("db"); ok {
+			if tag == "-" {
+				continue
+			}
+
+			name = tag
+		}
+
+		fieldByColumn[name] = i
+	}
+
+	indexes := make([]int, len(columns))
+
+	for i, column := range columns {
+		if j, ok := fieldByColumn[strings.ToLower(column)]; ok {
+			indexes[i] = j
+		} else {
+			indexes[i] = -1
+		}
+	}
+
+	return indexes, nil
+}
+
+// scanTargets builds the destination pointers handed to rows.Scan for a single
+// row: one field pointer per mapped column, a throwaway for the others.
+func scanTargets(value any, indexes []int) []any {
+	if indexes == nil {
+		return []any{value}
+	}
+
+	v := reflect.ValueOf(value).Elem()
+	targets := make([]any, len(indexes))
+
+	for i, j := range indexes {
+		if j >= 0 {
+			targets[i] = v.Field(j).Addr().Interface()
+		} else {
+			targets[i] = new(any)
+		}
+	}
+
+	return targets
+}
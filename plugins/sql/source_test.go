@@ -0,0 +1,295 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package rosql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDriver serves in-memory datasets registered per DSN, so that the tests
+// do not depend on an external database.
+type fakeDriver struct{}
+
+type fakeDataset struct {
+	columns  []string
+	rows     [][]driver.Value
+	queryErr error
+	rowsErr  error
+}
+
+var (
+	fakeDatasetsMu sync.Mutex
+	fakeDatasets   = map[string]fakeDataset{}
+)
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fakeDatasetsMu.Lock()
+	defer fakeDatasetsMu.Unlock()
+
+	dataset, ok := fakeDatasets[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown dataset: %s", name)
+	}
+
+	return &fakeConn{dataset: dataset}, nil
+}
+
+type fakeConn struct {
+	dataset fakeDataset
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{dataset: c.dataset}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not implemented")
+}
+
+type fakeStmt struct {
+	dataset fakeDataset
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.dataset.queryErr != nil {
+		return nil, s.dataset.queryErr
+	}
+
+	return &fakeRows{dataset: s.dataset}, nil
+}
+
+type fakeRows struct {
+	dataset fakeDataset
+	cursor  int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.dataset.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.cursor >= len(r.dataset.rows) {
+		if r.dataset.rowsErr != nil {
+			return r.dataset.rowsErr
+		}
+
+		return io.EOF
+	}
+
+	copy(dest, r.dataset.rows[r.cursor])
+	r.cursor++
+
+	return nil
+}
+
+func init() {
+	sql.Register("fake", fakeDriver{})
+}
+
+func openFakeDB(t *testing.T, dataset fakeDataset) *sql.DB {
+	t.Helper()
+
+	fakeDatasetsMu.Lock()
+	fakeDatasets[t.Name()] = dataset
+	fakeDatasetsMu.Unlock()
+
+	db, err := sql.Open("fake", t.Name())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	return db
+}
+
+type user struct {
+	ID       int64  `db:"id"`
+	Name     string `db:"name"`
+	Email    string
+	internal string //nolint:unused
+	Skipped  string `db:"-"`
+}
+
+func TestNewRowsObservable(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	db := openFakeDB(t, fakeDataset{
+		columns: []string{"id", "name", "email", "skipped"},
+		rows: [][]driver.Value{
+			{int64(1), "alice", "alice@example.com", "x"},
+			{int64(2), "bob", "bob@example.com", "y"},
+		},
+	})
+
+	values, err := ro.Collect(
+		NewRowsObservable[user](context.Background(), db, "SELECT id, name, email, skipped FROM users"),
+	)
+	is.NoError(err)
+	is.Equal([]user{
+		{ID: 1, Name: "alice", Email: "alice@example.com"},
+		{ID: 2, Name: "bob", Email: "bob@example.com"},
+	}, values)
+}
+
+func TestNewRowsObservableScalar(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	db := openFakeDB(t, fakeDataset{
+		columns: []string{"name"},
+		rows: [][]driver.Value{
+			{"alice"},
+			{"bob"},
+		},
+	})
+
+	values, err := ro.Collect(
+		NewRowsObservable[string](context.Background(), db, "SELECT name FROM users"),
+	)
+	is.NoError(err)
+	is.Equal([]string{"alice", "bob"}, values)
+
+	values2, err := ro.Collect(
+		NewRowsObservable[string](context.Background(), db, "SELECT name FROM users"),
+	)
+	is.NoError(err)
+	is.Equal([]string{"alice", "bob"}, values2)
+}
+
+func TestNewRowsObservableScalarTooManyColumns(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	db := openFakeDB(t, fakeDataset{
+		columns: []string{"id", "name"},
+		rows: [][]driver.Value{
+			{int64(1), "alice"},
+		},
+	})
+
+	values, err := ro.Collect(
+		NewRowsObservable[string](context.Background(), db, "SELECT id, name FROM users"),
+	)
+	is.EqualError(err, "rosql.NewRowsObservable: cannot scan 2 columns into string")
+	is.Empty(values)
+}
+
+func TestNewRowsObservableQueryError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	db := openFakeDB(t, fakeDataset{
+		queryErr: assert.AnError,
+	})
+
+	values, err := ro.Collect(
+		NewRowsObservable[user](context.Background(), db, "SELECT id FROM users"),
+	)
+	is.ErrorIs(err, assert.AnError)
+	is.ErrorContains(err, "rosql.NewRowsObservable: ")
+	is.Empty(values)
+}
+
+func TestNewRowsObservableRowsError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	db := openFakeDB(t, fakeDataset{
+		columns: []string{"id", "name", "email", "skipped"},
+		rows: [][]driver.Value{
+			{int64(1), "alice", "alice@example.com", "x"},
+		},
+		rowsErr: assert.AnError,
+	})
+
+	values, err := ro.Collect(
+		NewRowsObservable[user](context.Background(), db, "SELECT id, name, email, skipped FROM users"),
+	)
+	is.ErrorIs(err, assert.AnError)
+	is.ErrorContains(err, "rosql.NewRowsObservable: ")
+	is.Equal([]user{{ID: 1, Name: "alice", Email: "alice@example.com"}}, values)
+}
+
+func TestNewRowsObservableScanError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	db := openFakeDB(t, fakeDataset{
+		columns: []string{"id"},
+		rows: [][]driver.Value{
+			{"not-a-number"},
+		},
+	})
+
+	values, err := ro.Collect(
+		NewRowsObservable[user](context.Background(), db, "SELECT id FROM users"),
+	)
+	is.Error(err)
+	is.ErrorContains(err, "rosql.NewRowsObservable: ")
+	is.Empty(values)
+}
+
+func TestNewRowsObservableContextCanceled(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	db := openFakeDB(t, fakeDataset{
+		columns: []string{"name"},
+		rows: [][]driver.Value{
+			{"alice"},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	values, err := ro.Collect(
+		NewRowsObservable[string](ctx, db, "SELECT name FROM users"),
+	)
+	is.ErrorIs(err, context.Canceled)
+	is.Empty(values)
+}
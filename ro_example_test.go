@@ -806,6 +806,44 @@ func ExamplePairwise_error() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExampleIntersperse() {
+	observable := Pipe1(
+		Just("a", "b", "c"),
+		Intersperse(","),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: a
+	// Next: ,
+	// Next: b
+	// Next: ,
+	// Next: c
+	// Completed
+}
+
+func ExampleInterleave() {
+	observable := Interleave(
+		Just(1, 4),
+		Just(2, 5, 7),
+		Just(3, 6),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Next: 4
+	// Next: 5
+	// Next: 6
+	// Completed
+}
+
 func ExampleRaceWith_ok() {
 	observable := Pipe1(
 		Just(1, 2, 3),
@@ -2317,6 +2355,63 @@ func ExampleFilter_error() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExampleFilterErr_ok() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		FilterErr(func(i int) (bool, error) {
+			return i%2 == 0, nil
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 2
+	// Next: 4
+	// Completed
+}
+
+func ExampleFilterErr_error() {
+	observable1 := Pipe1(
+		NewObservable(func(observer Observer[int]) Teardown {
+			observer.Next(1)
+			observer.Next(2)
+			observer.Next(3)
+			observer.Error(assert.AnError)
+			observer.Next(4)
+
+			return nil
+		}),
+		FilterErr(func(i int) (bool, error) {
+			return i%2 == 0, nil
+		}),
+	)
+
+	subscription1 := observable1.Subscribe(PrintObserver[int]())
+	defer subscription1.Unsubscribe()
+
+	observable2 := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		FilterErr(func(i int) (bool, error) {
+			if i == 3 {
+				return false, assert.AnError
+			}
+
+			return i%2 == 0, nil
+		}),
+	)
+
+	subscription2 := observable2.Subscribe(PrintObserver[int]())
+	defer subscription2.Unsubscribe()
+
+	// Output:
+	// Next: 2
+	// Error: assert.AnError general error for testing
+	// Next: 2
+	// Error: assert.AnError general error for testing
+}
+
 func ExampleDistinct_ok() {
 	observable := Pipe1(
 		Just(1, 1, 2, 2, 3, 3, 4, 4, 5, 5),
@@ -3951,6 +4046,48 @@ func ExampleFlatMap_error() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExampleFlatMapErr_ok() {
+	observable := Pipe1(
+		Just(1, 2, 3),
+		FlatMapErr(func(item int) (Observable[int], error) {
+			return Just(item, item), nil
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 1
+	// Next: 2
+	// Next: 2
+	// Next: 3
+	// Next: 3
+	// Completed
+}
+
+func ExampleFlatMapErr_error() {
+	observable := Pipe1(
+		Just(1, 2, 3),
+		FlatMapErr(func(item int) (Observable[int], error) {
+			if item == 2 {
+				return nil, assert.AnError
+			}
+
+			return Just(item, item), nil
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 1
+	// Error: assert.AnError general error for testing
+}
+
 func ExampleScan_ok() {
 	observable := Pipe1(
 		Just(1, 2, 3, 4, 5),
@@ -4078,6 +4215,43 @@ func ExampleBufferWhen_error() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExampleWindowWithCount_ok() {
+	observable := Pipe3(
+		Just(1, 2, 3, 4, 5),
+		WindowWithCount[int](2),
+		Map(func(window Observable[int]) Observable[int] {
+			return Pipe1(window, Sum[int]())
+		}),
+		MergeAll[int](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription.Wait() // Note: using .Wait() is not recommended.
+
+	// Output:
+	// Next: 3
+	// Next: 7
+	// Next: 5
+	// Completed
+}
+
+func ExampleWindowWithTime_ok() {
+	observable := Pipe2(
+		RangeWithInterval(1, 4, 50*time.Millisecond),
+		WindowWithTime[int64](125*time.Millisecond),
+		MergeAll[int64](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int64]())
+	subscription.Wait() // Note: using .Wait() is not recommended.
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Completed
+}
+
 func ExampleBufferWithTimeOrCount_ok() {
 	observable := Pipe1(
 		Just(1, 2, 3, 4, 5),
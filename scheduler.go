@@ -14,6 +14,91 @@
 
 package ro
 
+import "sync"
+
+// Scheduler schedules units of work on a goroutine of its choosing. It is
+// consumed by ObserveOnScheduler and SubscribeOnScheduler to decouple
+// notification delivery from the producer goroutine. Both operators serialize
+// the tasks they submit, so per-subscription ordering is preserved even with
+// a worker pool.
+type Scheduler interface {
+	Schedule(task func())
+}
+
+// NewSynchronousScheduler returns a Scheduler that runs each task inline on
+// the caller goroutine.
+func NewSynchronousScheduler() Scheduler {
+	return synchronousScheduler{}
+}
+
+type synchronousScheduler struct{}
+
+func (synchronousScheduler) Schedule(task func()) {
+	task()
+}
+
+// NewGoroutineScheduler returns a Scheduler that runs each task on a fresh
+// goroutine.
+func NewGoroutineScheduler() Scheduler {
+	return goroutineScheduler{}
+}
+
+type goroutineScheduler struct{}
+
+func (goroutineScheduler) Schedule(task func()) {
+	go task()
+}
+
+// NewWorkerPoolScheduler returns a Scheduler backed by a fixed pool of
+// workers. Stop must be called once the scheduler is not needed anymore, to
+// release the workers; scheduling after Stop panics.
+// It panics if size is lower than 1.
+func NewWorkerPoolScheduler(size int) *WorkerPoolScheduler {
+	if size < 1 {
+		panic(ErrWorkerPoolSchedulerWrongSize)
+	}
+
+	scheduler := &WorkerPoolScheduler{
+		tasks: make(chan func(), size),
+	}
+
+	for i := 0; i < size; i++ {
+		scheduler.wg.Add(1)
+
+		go func() {
+			defer scheduler.wg.Done()
+
+			for task := range scheduler.tasks {
+				task()
+			}
+		}()
+	}
+
+	return scheduler
+}
+
+// WorkerPoolScheduler is a Scheduler backed by a fixed pool of goroutines.
+type WorkerPoolScheduler struct {
+	tasks chan func()
+	wg    sync.WaitGroup
+	once  sync.Once
+}
+
+// Schedule submits a task to the pool. It blocks when all workers are busy
+// and the internal queue is full.
+func (s *WorkerPoolScheduler) Schedule(task func()) {
+	s.tasks <- task
+}
+
+// Stop closes the pool and waits for the workers to finish their pending
+// tasks. It is safe to call Stop multiple times.
+func (s *WorkerPoolScheduler) Stop() {
+	s.once.Do(func() {
+		close(s.tasks)
+		s.wg.Wait()
+	})
+}
+
 // NewScheduler just trolls other languages. 😈
 // https://reactivex.io/documentation/scheduler.html
 func NewScheduler() {
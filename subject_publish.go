@@ -201,6 +201,225 @@ func (s *publishSubjectImpl[T]) AsObserver() Observer[T] {
 	return s
 }
 
+var _ Subject[int] = (*publishSubjectWithConfigImpl[int])(nil)
+
+// SubjectOverflowStrategy is the policy applied by NewPublishSubjectWithConfig
+// to a subscriber whose buffer is full.
+type SubjectOverflowStrategy int8
+
+const (
+	// SubjectOverflowStrategyBlock blocks the producer until the slow
+	// subscriber drains its buffer.
+	SubjectOverflowStrategyBlock SubjectOverflowStrategy = iota
+	// SubjectOverflowStrategyDrop drops the incoming value for the slow
+	// subscriber only. Other subscribers still receive it.
+	SubjectOverflowStrategyDrop
+	// SubjectOverflowStrategyError terminates the slow subscriber with
+	// ErrSubjectBufferOverflow. Other subscribers are unaffected.
+	SubjectOverflowStrategyError
+	// SubjectOverflowStrategyDisconnect silently unsubscribes the slow
+	// subscriber, after delivering the values already buffered. Other
+	// subscribers are unaffected.
+	SubjectOverflowStrategyDisconnect
+)
+
+// SubjectConfig configures the per-subscriber buffering of
+// NewPublishSubjectWithConfig.
+type SubjectConfig struct {
+	// BufferSize is the capacity of each subscriber buffer. Must be greater
+	// than 0.
+	BufferSize int
+	// OverflowStrategy is the policy applied to a subscriber whose buffer
+	// is full.
+	OverflowStrategy SubjectOverflowStrategy
+}
+
+// NewPublishSubjectWithConfig broadcasts a value to observers (fanout), like
+// NewPublishSubject, but delivers to each subscriber through its own buffer
+// and a dedicated goroutine. A slow subscriber no longer stalls the producer
+// nor the other subscribers: when its buffer is full, the configured overflow
+// strategy is applied. SubjectOverflowStrategyBlock still blocks the producer,
+// but only once the buffer is exhausted.
+func NewPublishSubjectWithConfig[T any](config SubjectConfig) Subject[T] {
+	if config.BufferSize < 1 {
+		panic(ErrPublishSubjectWithConfigWrongBufferSize)
+	}
+
+	switch config.OverflowStrategy {
+	case SubjectOverflowStrategyBlock, SubjectOverflowStrategyDrop, SubjectOverflowStrategyError, SubjectOverflowStrategyDisconnect:
+		// fallthrough
+	default:
+		panic(ErrPublishSubjectWithConfigWrongStrategy)
+	}
+
+	return &publishSubjectWithConfigImpl[T]{
+		subject: NewPublishSubject[T](),
+		config:  config,
+	}
+}
+
+type publishSubjectWithConfigImpl[T any] struct {
+	subject Subject[T]
+	config  SubjectConfig
+}
+
+// Implements Observable.
+func (s *publishSubjectWithConfigImpl[T]) Subscribe(destination Observer[T]) Subscription {
+	return s.SubscribeWithContext(context.Background(), destination)
+}
+
+// Implements Observable.
+func (s *publishSubjectWithConfigImpl[T]) SubscribeWithContext(subscriberCtx context.Context, destination Observer[T]) Subscription {
+	out := NewSubscriber(destination)
+	ch := make(chan lo.Tuple2[context.Context, Notification[T]], s.config.BufferSize)
+
+	once := sync.Once{}
+	stop := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+
+	overflowOnce := sync.Once{}
+	overflow := uint32(0)
+
+	var overflowCtx context.Context
+
+	go recoverUnhandledError(func() {
+		for notification := range ch {
+			processNotificationWithContext(
+				notification.A,
+				notification.B,
+				out.NextWithContext,
+				out.ErrorWithContext,
+				out.CompleteWithContext,
+			)
+		}
+
+		// Once the buffered values have been delivered, the subscription is
+		// terminated with the overflow error, or simply closed when the
+		// subscriber has been disconnected without a terminal notification.
+		if atomic.LoadUint32(&overflow) == 1 {
+			out.ErrorWithContext(overflowCtx, ErrSubjectBufferOverflow)
+		} else {
+			out.Unsubscribe()
+		}
+	})
+
+	var inner Subscription
+
+	inner = s.subject.SubscribeWithContext(
+		subscriberCtx,
+		NewObserverWithContext(
+			func(ctx context.Context, value T) {
+				switch s.config.OverflowStrategy {
+				case SubjectOverflowStrategyBlock:
+					ch <- lo.T2(ctx, NewNotificationNext(value))
+				case SubjectOverflowStrategyDrop:
+					select {
+					case ch <- lo.T2(ctx, NewNotificationNext(value)):
+					default:
+						OnDroppedNotification(ctx, NewNotificationNext(value))
+					}
+				case SubjectOverflowStrategyError:
+					select {
+					case ch <- lo.T2(ctx, NewNotificationNext(value)):
+					default:
+						// The consumer might be blocked in a delivery, so the
+						// overflow error is left for the consumer goroutine to
+						// deliver, without stalling the producer.
+						overflowOnce.Do(func() {
+							overflowCtx = ctx
+							atomic.StoreUint32(&overflow, 1)
+						})
+						inner.Unsubscribe()
+					}
+				case SubjectOverflowStrategyDisconnect:
+					select {
+					case ch <- lo.T2(ctx, NewNotificationNext(value)):
+					default:
+						OnDroppedNotification(ctx, NewNotificationNext(value))
+						inner.Unsubscribe()
+					}
+				}
+			},
+			func(ctx context.Context, err error) {
+				ch <- lo.T2(ctx, NewNotificationError[T](err))
+			},
+			func(ctx context.Context) {
+				ch <- lo.T2(ctx, NewNotificationComplete[T]())
+			},
+		),
+	)
+
+	// Runs immediately if the subject was already terminated.
+	inner.Add(stop)
+	out.AddUnsubscribable(inner)
+
+	return out
+}
+
+// Implements Observer.
+func (s *publishSubjectWithConfigImpl[T]) Next(value T) {
+	s.subject.Next(value)
+}
+
+// Implements Observer.
+func (s *publishSubjectWithConfigImpl[T]) NextWithContext(ctx context.Context, value T) {
+	s.subject.NextWithContext(ctx, value)
+}
+
+// Implements Observer.
+func (s *publishSubjectWithConfigImpl[T]) Error(err error) {
+	s.subject.Error(err)
+}
+
+// Implements Observer.
+func (s *publishSubjectWithConfigImpl[T]) ErrorWithContext(ctx context.Context, err error) {
+	s.subject.ErrorWithContext(ctx, err)
+}
+
+// Implements Observer.
+func (s *publishSubjectWithConfigImpl[T]) Complete() {
+	s.subject.Complete()
+}
+
+// Implements Observer.
+func (s *publishSubjectWithConfigImpl[T]) CompleteWithContext(ctx context.Context) {
+	s.subject.CompleteWithContext(ctx)
+}
+
+func (s *publishSubjectWithConfigImpl[T]) HasObserver() bool {
+	return s.subject.HasObserver()
+}
+
+func (s *publishSubjectWithConfigImpl[T]) CountObservers() int {
+	return s.subject.CountObservers()
+}
+
+// Implements Observer.
+func (s *publishSubjectWithConfigImpl[T]) IsClosed() bool {
+	return s.subject.IsClosed()
+}
+
+// Implements Observer.
+func (s *publishSubjectWithConfigImpl[T]) HasThrown() bool {
+	return s.subject.HasThrown()
+}
+
+// Implements Observer.
+func (s *publishSubjectWithConfigImpl[T]) IsCompleted() bool {
+	return s.subject.IsCompleted()
+}
+
+func (s *publishSubjectWithConfigImpl[T]) AsObservable() Observable[T] {
+	return s
+}
+
+func (s *publishSubjectWithConfigImpl[T]) AsObserver() Observer[T] {
+	return s
+}
+
 func (s *publishSubjectImpl[T]) broadcastNext(ctx context.Context, value T) {
 	s.observers.Range(func(_, observer any) bool {
 		observer.(Observer[T]).NextWithContext(ctx, value) //nolint:errcheck,forcetypeassert
@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -453,3 +454,223 @@ func TestPublishSubject_complete(t *testing.T) {
 	subscription3.Unsubscribe()
 	subscription4.Unsubscribe()
 }
+
+func TestPublishSubjectWithConfig_panics(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.PanicsWithError("ro.NewPublishSubjectWithConfig: buffer size must be greater than 0", func() {
+		NewPublishSubjectWithConfig[int](SubjectConfig{BufferSize: 0})
+	})
+
+	is.PanicsWithError("ro.NewPublishSubjectWithConfig: unexpected overflow strategy", func() {
+		NewPublishSubjectWithConfig[int](SubjectConfig{BufferSize: 1, OverflowStrategy: SubjectOverflowStrategy(42)})
+	})
+}
+
+func TestPublishSubjectWithConfig_multicast(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	subject := NewPublishSubjectWithConfig[int](SubjectConfig{
+		BufferSize:       8,
+		OverflowStrategy: SubjectOverflowStrategyBlock,
+	})
+
+	var counter1 int64
+	var counter2 int64
+
+	incOnNext := func(counter *int64) Observer[int] {
+		return OnNext(func(value int) { atomic.AddInt64(counter, int64(value)) })
+	}
+
+	subscription1 := subject.Subscribe(incOnNext(&counter1))
+	subscription2 := subject.Subscribe(incOnNext(&counter2))
+	is.Equal(2, subject.CountObservers())
+
+	subject.Next(21)
+	subject.Next(21)
+	subject.Complete()
+
+	subscription1.Wait()
+	subscription2.Wait()
+
+	is.Equal(int64(42), atomic.LoadInt64(&counter1))
+	is.Equal(int64(42), atomic.LoadInt64(&counter2))
+	is.True(subject.IsCompleted())
+}
+
+func TestPublishSubjectWithConfig_dropSlowSubscriber(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	subject := NewPublishSubjectWithConfig[int](SubjectConfig{
+		BufferSize:       1,
+		OverflowStrategy: SubjectOverflowStrategyDrop,
+	})
+
+	received := make(chan struct{})
+	release := make(chan struct{})
+
+	sync := lo.Synchronize()
+	slowValues := []int{}
+	fastValues := []int{}
+
+	slow := subject.Subscribe(NewObserver(
+		func(value int) {
+			sync.Do(func() { slowValues = append(slowValues, value) })
+
+			if value == 1 {
+				received <- struct{}{}
+				<-release
+			}
+		},
+		nil,
+		nil,
+	))
+	fastAck := make(chan struct{}, 16)
+	fast := subject.Subscribe(OnNext(func(value int) {
+		sync.Do(func() { fastValues = append(fastValues, value) })
+		fastAck <- struct{}{}
+	}))
+
+	subject.Next(1)
+	// the slow subscriber is now blocked in its delivery, with an empty buffer
+	<-received
+	<-fastAck
+	subject.Next(2) // fills the slow subscriber's buffer
+	<-fastAck
+	subject.Next(3) // overflows: dropped for the slow subscriber only
+	<-fastAck
+
+	close(release)
+	subject.Complete()
+
+	slow.Wait()
+	fast.Wait()
+
+	sync.Do(func() {
+		is.Equal([]int{1, 2}, slowValues)
+		is.Equal([]int{1, 2, 3}, fastValues)
+	})
+}
+
+func TestPublishSubjectWithConfig_errorSlowSubscriber(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	subject := NewPublishSubjectWithConfig[int](SubjectConfig{
+		BufferSize:       1,
+		OverflowStrategy: SubjectOverflowStrategyError,
+	})
+
+	received := make(chan struct{})
+	release := make(chan struct{})
+
+	sync := lo.Synchronize()
+	fastValues := []int{}
+
+	slowErr := make(chan error, 1)
+
+	slow := subject.Subscribe(NewObserver(
+		func(value int) {
+			if value == 1 {
+				received <- struct{}{}
+				<-release
+			}
+		},
+		func(err error) {
+			slowErr <- err
+		},
+		nil,
+	))
+	fastAck := make(chan struct{}, 16)
+	fast := subject.Subscribe(OnNext(func(value int) {
+		sync.Do(func() { fastValues = append(fastValues, value) })
+		fastAck <- struct{}{}
+	}))
+
+	subject.Next(1)
+	<-received
+	<-fastAck
+	subject.Next(2) // fills the slow subscriber's buffer
+	<-fastAck
+	subject.Next(3) // overflows: the slow subscriber is terminated
+	<-fastAck
+
+	is.Equal(1, subject.CountObservers())
+
+	close(release)
+	subject.Complete()
+
+	slow.Wait()
+	fast.Wait()
+
+	is.Equal(ErrSubjectBufferOverflow, <-slowErr)
+	sync.Do(func() {
+		is.Equal([]int{1, 2, 3}, fastValues)
+	})
+}
+
+func TestPublishSubjectWithConfig_disconnectSlowSubscriber(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	subject := NewPublishSubjectWithConfig[int](SubjectConfig{
+		BufferSize:       1,
+		OverflowStrategy: SubjectOverflowStrategyDisconnect,
+	})
+
+	received := make(chan struct{})
+	release := make(chan struct{})
+
+	sync := lo.Synchronize()
+	slowValues := []int{}
+	fastValues := []int{}
+
+	slow := subject.Subscribe(NewObserver(
+		func(value int) {
+			sync.Do(func() { slowValues = append(slowValues, value) })
+
+			if value == 1 {
+				received <- struct{}{}
+				<-release
+			}
+		},
+		nil,
+		nil,
+	))
+	fastAck := make(chan struct{}, 16)
+	fast := subject.Subscribe(OnNext(func(value int) {
+		sync.Do(func() { fastValues = append(fastValues, value) })
+		fastAck <- struct{}{}
+	}))
+
+	subject.Next(1)
+	<-received
+	<-fastAck
+	subject.Next(2) // fills the slow subscriber's buffer
+	<-fastAck
+	subject.Next(3) // overflows: the slow subscriber is disconnected
+	<-fastAck
+
+	is.Equal(1, subject.CountObservers())
+
+	close(release)
+	subject.Complete()
+
+	slow.Wait()
+	fast.Wait()
+
+	is.True(slow.IsClosed())
+
+	// the values buffered before the disconnection are still delivered
+	sync.Do(func() {
+		is.Equal([]int{1, 2}, slowValues)
+		is.Equal([]int{1, 2, 3}, fastValues)
+	})
+}
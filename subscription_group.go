@@ -0,0 +1,104 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"sync"
+)
+
+// SubscriptionGroup coordinates the shutdown of many pipelines. It gathers
+// subscriptions as pipelines are started, and offers a single place to wait
+// for all of them to terminate or to tear them all down at once. It
+// standardizes the "start N pipelines, shut them all down cleanly on SIGTERM"
+// pattern.
+type SubscriptionGroup struct {
+	mu            sync.Mutex
+	subscriptions []Subscription
+}
+
+// NewSubscriptionGroup creates an empty SubscriptionGroup.
+func NewSubscriptionGroup() *SubscriptionGroup {
+	return &SubscriptionGroup{
+		mu:            sync.Mutex{},
+		subscriptions: []Subscription{},
+	}
+}
+
+// Add registers a subscription in the group. The method does nothing if
+// `subscription` is nil.
+//
+// This method is thread-safe.
+func (g *SubscriptionGroup) Add(subscription Subscription) {
+	if subscription == nil {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.subscriptions = append(g.subscriptions, subscription)
+}
+
+// UnsubscribeAll disposes every subscription in the group and empties it.
+//
+// This method is thread-safe.
+func (g *SubscriptionGroup) UnsubscribeAll() {
+	g.mu.Lock()
+	subscriptions := g.subscriptions
+	g.subscriptions = []Subscription{}
+	g.mu.Unlock()
+
+	for i := range subscriptions {
+		subscriptions[i].Unsubscribe()
+	}
+}
+
+// Wait blocks until every subscription currently in the group terminates, or
+// until ctx is canceled, in which case the remaining subscriptions are
+// unsubscribed and the context error is returned. Subscriptions added after
+// the call are not awaited.
+//
+// Please use it carefully. Like Subscription.Wait, blocking is against the
+// Reactive Programming Manifesto.
+func (g *SubscriptionGroup) Wait(ctx context.Context) error {
+	g.mu.Lock()
+	subscriptions := make([]Subscription, len(g.subscriptions))
+	copy(subscriptions, g.subscriptions)
+	g.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		for i := range subscriptions {
+			subscriptions[i].Wait()
+		}
+
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		g.UnsubscribeAll()
+
+		// unsubscription closes the remaining subscriptions, so the
+		// goroutine above is guaranteed to finish
+		<-done
+
+		return ctx.Err()
+	}
+}
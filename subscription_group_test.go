@@ -0,0 +1,93 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionGroupAdd(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	group := NewSubscriptionGroup()
+
+	sub1 := NewSubscription(nil)
+	sub2 := NewSubscription(nil)
+
+	group.Add(sub1)
+	group.Add(sub2)
+	group.Add(nil)
+
+	is.False(sub1.IsClosed())
+	is.False(sub2.IsClosed())
+
+	group.UnsubscribeAll()
+
+	is.True(sub1.IsClosed())
+	is.True(sub2.IsClosed())
+}
+
+func TestSubscriptionGroupWait(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	group := NewSubscriptionGroup()
+
+	// pipelines that terminate on their own
+	subject1 := NewPublishSubject[int]()
+	sub1 := subject1.Subscribe(NoopObserver[int]())
+	group.Add(sub1)
+
+	subject2 := NewPublishSubject[int]()
+	sub2 := subject2.Subscribe(NoopObserver[int]())
+	group.Add(sub2)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		subject1.Complete()
+		time.Sleep(10 * time.Millisecond)
+		subject2.Complete()
+	}()
+
+	err := group.Wait(context.Background())
+	is.NoError(err)
+	is.True(sub1.IsClosed())
+	is.True(sub2.IsClosed())
+}
+
+func TestSubscriptionGroupWaitCancellation(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	group := NewSubscriptionGroup()
+
+	// never-ending pipeline
+	sub := Never().Subscribe(NoopObserver[struct{}]())
+	group.Add(sub)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := group.Wait(ctx)
+	is.ErrorIs(err, context.DeadlineExceeded)
+	is.True(sub.IsClosed())
+}